@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// runBackfill extracts the user, source IP, response code and impersonation
+// columns for events stored before these columns existed
+func runBackfill(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("backfill", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	batchSize := flags.Int("batch-size", ingestion.DefaultBackfillBatchSize, "number of events updated per transaction")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+
+	entClient, _, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	ctx := context.Background()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+
+	updated, err := ingestion.Backfill(ctx, entClient, *batchSize)
+	log.Printf("backfilled %d audit events", updated)
+	return err
+}
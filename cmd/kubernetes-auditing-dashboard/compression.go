@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/config"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+// applyRawCodec configures the process-wide raw codec, compressing and encrypting raw payloads
+func applyRawCodec(cfg *config.Config) error {
+	c := &cfg.RawCompression
+	ok, level := zstd.EncoderLevelFromString(c.Level)
+	if !ok {
+		return fmt.Errorf("unknown raw compression level %q", c.Level)
+	}
+	opts := rawcodec.Options{Disabled: c.Disabled, Level: level}
+	if c.Dictionary != "" {
+		dictionary, err := os.ReadFile(c.Dictionary)
+		if err != nil {
+			return err
+		}
+		opts.Dictionary = dictionary
+	}
+	if cfg.RawEncryption.Keyring != "" {
+		keyring, err := rawcodec.LoadKeyring(cfg.RawEncryption.Keyring)
+		if err != nil {
+			return err
+		}
+		opts.Keyring = keyring
+	}
+	return rawcodec.Configure(opts)
+}
+
+// runCompressRaw compresses the raw payloads of events stored before compression,
+// reports compression statistics, or trains a dictionary from stored events
+func runCompressRaw(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("compress-raw", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	batchSize := flags.Int("batch-size", ingestion.DefaultCompressBatchSize, "number of events rewritten per transaction")
+	statsOnly := flags.Bool("stats", false, "only report the compression ratio")
+	trainDictionary := flags.String("train-dictionary", "", "write a zstd dictionary trained on stored events to this file instead of compressing")
+	samples := flags.Int("samples", 2000, "number of recent events the dictionary is trained on")
+	dictionarySize := flags.Int("dictionary-size", rawcodec.DefaultDictionarySize, "maximum size of the trained dictionary in bytes")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+
+	entClient, _, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	ctx := context.Background()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+
+	if *trainDictionary != "" {
+		raws, err := ingestion.SampleRaw(ctx, entClient, *samples)
+		if err != nil {
+			return err
+		}
+		dictionary, err := rawcodec.TrainDictionary(raws, *dictionarySize)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*trainDictionary, dictionary, 0o644); err != nil {
+			return err
+		}
+		log.Printf("wrote a %d bytes dictionary trained on %d events to %s, pass it with -raw-dictionary", len(dictionary), len(raws), *trainDictionary)
+		return nil
+	}
+
+	if !*statsOnly {
+		compressed, err := ingestion.CompressRaw(ctx, entClient, *batchSize)
+		log.Printf("compressed %d audit events", compressed)
+		if err != nil {
+			return err
+		}
+	}
+
+	stats, err := ingestion.GetRawStorageStats(ctx, entClient)
+	if err != nil {
+		return err
+	}
+	log.Printf("%d events, %d not compressed yet: %d bytes stored for %d bytes of payloads (ratio %.2f)",
+		stats.Rows, stats.PendingRows, stats.StoredBytes, stats.UncompressedBytes, stats.Ratio())
+	return nil
+}
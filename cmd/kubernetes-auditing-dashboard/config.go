@@ -0,0 +1,171 @@
+package main
+
+import (
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/config"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/forwarding"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+)
+
+// loadConfig reads the configuration file named by -config or KAD_CONFIG and
+// applies the environment overrides. Commands then register the flags of the
+// settings they use, defaulting to the loaded values, and call parseFlags.
+func loadConfig(args []string) (*config.Config, error) {
+	cfg, err := config.Load(config.PathFromArgs(args, os.LookupEnv))
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// parseFlags registers -config, already read by loadConfig, and parses the arguments
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	flags.String("config", "", fmt.Sprintf("YAML configuration file, also read from %s; settings are overridden by %s* environment variables, then by flags", config.EnvConfigFile, config.EnvPrefix))
+	return flags.Parse(args)
+}
+
+// serveDependencies are loaded from the files the serve configuration refers to
+type serveDependencies struct {
+	authenticator   *ingestion.Authenticator
+	retentionPolicy *retention.Policy
+	processors      *ingestProcessors
+	// forwarder is nil when no forwarding sink is configured
+	forwarder *forwarding.Forwarder
+}
+
+// loadServeDependencies validates the configuration and loads every file it
+// refers to, so that serve fails at startup rather than later
+func loadServeDependencies(cfg *config.Config) (*serveDependencies, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return nil, err
+	}
+	retentionPolicy, err := cfg.Retention.LoadPolicy()
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := newWebhookAuthenticator(&cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+	processors, err := loadIngestProcessors(&cfg.Ingestion)
+	if err != nil {
+		return nil, err
+	}
+	deps := &serveDependencies{authenticator: authenticator, retentionPolicy: retentionPolicy, processors: processors}
+	if len(cfg.Forwarding.Sinks) > 0 {
+		forwarder, err := forwarding.NewForwarder(cfg.Forwarding.Sinks, cfg.Forwarding.QueueDir)
+		if err != nil {
+			return nil, err
+		}
+		deps.forwarder = forwarder
+	}
+	return deps, nil
+}
+
+// checkServeDependencies validates the configuration and checks every file it
+// refers to like loadServeDependencies, without writing anything: the redaction
+// key and the forwarding queues are only created by serve
+func checkServeDependencies(cfg *config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+	if _, err := cfg.Retention.LoadPolicy(); err != nil {
+		return err
+	}
+	if _, err := newWebhookAuthenticator(&cfg.Auth); err != nil {
+		return err
+	}
+	if err := checkIngestProcessors(&cfg.Ingestion); err != nil {
+		return err
+	}
+	if len(cfg.Forwarding.Sinks) > 0 {
+		return forwarding.CheckSinks(cfg.Forwarding.Sinks, cfg.Forwarding.QueueDir)
+	}
+	return nil
+}
+
+// newWebhookAuthenticator loads the webhook credentials and client CAs. At least
+// one credential is required unless anonymous requests are explicitly allowed.
+func newWebhookAuthenticator(auth *config.AuthConfig) (*ingestion.Authenticator, error) {
+	var credentials *ingestion.Credentials
+	if auth.WebhookCredentials != "" {
+		loaded, err := ingestion.LoadCredentials(auth.WebhookCredentials)
+		if err != nil {
+			return nil, err
+		}
+		credentials = loaded
+	}
+	var clientCAs *x509.CertPool
+	if auth.WebhookClientCA != "" {
+		pool, err := ingestion.LoadClientCAs(auth.WebhookClientCA)
+		if err != nil {
+			return nil, err
+		}
+		clientCAs = pool
+	}
+	authenticator := ingestion.NewAuthenticator(credentials, clientCAs)
+	if !authenticator.Enabled() && !auth.WebhookAllowAnonymous {
+		return nil, errors.New("the audit webhook requires credentials: set -webhook-credentials or -webhook-client-ca, or -webhook-allow-anonymous to accept unauthenticated requests")
+	}
+	return authenticator, nil
+}
+
+// logEffectiveConfig logs the configuration serve runs with
+func logEffectiveConfig(cfg *config.Config) {
+	dump, err := cfg.Dump()
+	if err != nil {
+		log.Printf("failed to dump the effective configuration: %v", err)
+		return
+	}
+	log.Printf("effective configuration:\n%s", dump)
+}
+
+// runConfig runs the configuration subcommands
+func runConfig(args []string) error {
+	if len(args) == 0 || args[0] != "validate" {
+		fmt.Fprintln(os.Stderr, "Usage: config validate [-config file] [serve flags]")
+		return errors.New("unknown config subcommand")
+	}
+	return runConfigValidate(args[1:])
+}
+
+// runConfigValidate checks the configuration serve would run with, including
+// the files it refers to, and prints the effective configuration. Nothing is
+// written, files serve would create are only checked to be creatable.
+func runConfigValidate(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("config validate", flag.ExitOnError)
+	cfg.AddFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := checkServeDependencies(cfg); err != nil {
+		return err
+	}
+	dump, err := cfg.Dump()
+	if err != nil {
+		return err
+	}
+	os.Stdout.Write(dump)
+	log.Print("the configuration is valid")
+	return nil
+}
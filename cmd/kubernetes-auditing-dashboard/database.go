@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+)
+
+// openDatabase opens the database and returns both the ent client and its underlying driver
+func openDatabase(dsn string) (*ent.Client, dialect.Driver, error) {
+	return database.Open(dsn)
+}
+
+// migrateDatabase runs the automatic migration tool to create all schema resources
+func migrateDatabase(ctx context.Context, client *ent.Client) error {
+	return database.Migrate(ctx, client)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// runDeadLetters runs the dead letter subcommands
+func runDeadLetters(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "list":
+			return runDeadLettersList(args[1:])
+		case "reprocess":
+			return runDeadLettersReprocess(args[1:])
+		}
+	}
+	fmt.Fprintln(os.Stderr, "Usage: dead-letters list|reprocess [flags]")
+	return errors.New("unknown dead-letters subcommand")
+}
+
+// deadLetterSelection selects dead letters by ID, kind and cluster
+type deadLetterSelection struct {
+	id      int
+	kind    string
+	cluster string
+}
+
+func (s *deadLetterSelection) addFlags(flags *flag.FlagSet) {
+	flags.IntVar(&s.id, "id", 0, "only select the dead letter with this ID")
+	flags.StringVar(&s.kind, "kind", "", fmt.Sprintf("only select dead letters of this kind: %s or %s", ingestion.DeadLetterBody, ingestion.DeadLetterEvent))
+	flags.StringVar(&s.cluster, "cluster", "", "only select dead letters received from this cluster")
+}
+
+func (s *deadLetterSelection) predicates() []predicate.DeadLetter {
+	var predicates []predicate.DeadLetter
+	if s.id != 0 {
+		predicates = append(predicates, deadletter.ID(s.id))
+	}
+	if s.kind != "" {
+		predicates = append(predicates, deadletter.Kind(s.kind))
+	}
+	if s.cluster != "" {
+		predicates = append(predicates, deadletter.Cluster(s.cluster))
+	}
+	return predicates
+}
+
+// openDeadLetterDatabase opens and migrates the database of the dead letter subcommands
+func openDeadLetterDatabase(ctx context.Context, dsn string) (*ent.Client, error) {
+	entClient, _, err := openDatabase(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		entClient.Close()
+		return nil, err
+	}
+	return entClient, nil
+}
+
+// runDeadLettersList prints the dead letters, most recent first
+func runDeadLettersList(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("dead-letters list", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	selection := &deadLetterSelection{}
+	selection.addFlags(flags)
+	limit := flags.Int("limit", 50, "maximum number of dead letters listed")
+	showPayload := flags.Bool("payload", false, "print the payload of every dead letter")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	entClient, err := openDeadLetterDatabase(ctx, cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+
+	letters, err := entClient.DeadLetter.Query().
+		Where(selection.predicates()...).
+		Order(ent.Desc(deadletter.FieldReceivedAt), ent.Desc(deadletter.FieldID)).
+		Limit(*limit).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	total, err := entClient.DeadLetter.Query().Where(selection.predicates()...).Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tRECEIVED\tKIND\tCLUSTER\tATTEMPTS\tBYTES\tREASON")
+	for _, letter := range letters {
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%d\t%d\t%s\n", letter.ID, letter.ReceivedAt.Format(time.RFC3339),
+			letter.Kind, letter.Cluster, letter.Attempts, len(letter.Payload), letter.Reason)
+		if *showPayload {
+			fmt.Fprintf(writer, "\t%s\n", letter.Payload)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	log.Printf("listed %d of %d dead letters", len(letters), total)
+	return nil
+}
+
+// runDeadLettersReprocess ingests the dead letters again, deleting those that succeed
+func runDeadLettersReprocess(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("dead-letters reprocess", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	cfg.Ingestion.AddProcessingFlags(flags)
+	flags.StringVar(&cfg.Ingestion.DefaultCluster, "default-cluster", cfg.Ingestion.DefaultCluster, "cluster of dead letters received without cluster attribution")
+	selection := &deadLetterSelection{}
+	selection.addFlags(flags)
+	batchSize := flags.Int("batch-size", ingestion.DefaultReprocessBatchSize, "number of dead letters loaded at once")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+	processors, err := loadIngestProcessors(&cfg.Ingestion)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	entClient, err := openDeadLetterDatabase(ctx, cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+
+	service := newIngestionService(entClient, processors, ingestion.WithDefaultCluster(cfg.Ingestion.DefaultCluster))
+	report, err := service.ReprocessDeadLetters(ctx, *batchSize, selection.predicates()...)
+	log.Printf("reprocessed %d dead letters storing %d audit events, %d still failing",
+		report.Reprocessed, report.Stored, report.Failed)
+	return err
+}
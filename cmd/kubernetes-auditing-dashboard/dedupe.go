@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// runDedupe removes events stored more than once for the same auditID and stage,
+// which databases created before the uniqueness guarantee may contain, and then
+// creates the unique index.
+func runDedupe(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("dedupe", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	dryRun := flags.Bool("dry-run", false, "only report how many duplicated events would be removed")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	entClient, drv, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	ctx := context.Background()
+
+	if *dryRun {
+		count, err := ingestion.CountDuplicates(ctx, drv)
+		if err != nil {
+			return err
+		}
+		log.Printf("%d duplicated audit events would be removed", count)
+		return nil
+	}
+
+	removed, err := ingestion.RemoveDuplicates(ctx, drv)
+	if err != nil {
+		return err
+	}
+	log.Printf("removed %d duplicated audit events", removed)
+	return migrateDatabase(ctx, entClient)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/logfile"
+)
+
+// runImport bulk-loads a directory of archived audit log files (plain, .gz or .zst)
+func runImport(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	cfg.Ingestion.AddProcessingFlags(flags)
+	checkpointPath := flags.String("checkpoint-file", "import.checkpoints.json", "file recording imported files so an interrupted import can resume, empty to disable")
+	dryRun := flags.Bool("dry-run", false, "only parse the files and report event counts by verb and resource")
+	options := logfile.DefaultImportOptions()
+	flags.IntVar(&options.Workers, "workers", options.Workers, "number of files parsed in parallel")
+	flags.IntVar(&options.BatchSize, "batch-size", options.BatchSize, "number of audit events written at once")
+	flags.DurationVar(&options.ProgressInterval, "progress-interval", options.ProgressInterval, "how often progress is reported, 0 to disable")
+	flags.StringVar(&options.Cluster, "cluster", cfg.Ingestion.DefaultCluster, "cluster the audit events are attributed to, the configured defaultCluster by default")
+	flags.Usage = func() {
+		flags.Output().Write([]byte("Usage: import [flags] <directory>\n"))
+		flags.PrintDefaults()
+	}
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("exactly one directory is required")
+	}
+	if err := ingestion.ValidateClusterName(options.Cluster); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *dryRun {
+		// Validation does not touch the database or the redaction key, and nothing is checkpointed
+		processors, err := loadDryRunProcessors(&cfg.Ingestion)
+		if err != nil {
+			return err
+		}
+		checkpoints, _ := logfile.LoadCheckpoints("")
+		sink := logfile.NewCountingSink()
+		importer := logfile.NewImporter(newIngestionService(nil, processors), sink, checkpoints, options)
+		report, err := importer.Import(ctx, flags.Arg(0))
+		if report != nil {
+			printImportReport(report)
+			printVerbResourceCounts(sink.Counts())
+		}
+		return err
+	}
+
+	processors, err := loadIngestProcessors(&cfg.Ingestion)
+	if err != nil {
+		return err
+	}
+	entClient, _, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+	checkpoints, err := logfile.LoadCheckpoints(*checkpointPath)
+	if err != nil {
+		return err
+	}
+
+	service := newIngestionService(entClient, processors)
+	importer := logfile.NewImporter(service, service, checkpoints, options)
+	report, err := importer.Import(ctx, flags.Arg(0))
+	if report != nil {
+		printImportReport(report)
+	}
+	return err
+}
+
+func printImportReport(report *logfile.ImportReport) {
+	log.Printf("read %d of %d files, %d events: %d submitted, %d rejected, %d dropped, %d undecodable",
+		report.Files, report.TotalFiles, report.Lines, report.Submitted, report.Rejected, report.Dropped, report.DecodeErrors)
+	for path, reason := range report.Failed {
+		log.Printf("failed to import %s: %s", path, reason)
+	}
+}
+
+func printVerbResourceCounts(counts []logfile.VerbResourceCount) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERB\tRESOURCE\tCOUNT")
+	for _, c := range counts {
+		resource := c.Resource
+		if resource == "" {
+			resource = "(non-resource)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\n", c.Verb, resource, c.Count)
+	}
+	w.Flush()
+}
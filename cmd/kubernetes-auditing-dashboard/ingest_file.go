@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/logfile"
+)
+
+// runIngestFile ingests audit log files written by the apiserver log backend (--audit-log-path)
+func runIngestFile(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("ingest-file", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	cfg.Ingestion.AddProcessingFlags(flags)
+	checkpointPath := flags.String("checkpoint-file", "ingest-file.checkpoints.json", "file remembering how far each audit log file has been ingested, empty to disable")
+	options := logfile.DefaultOptions()
+	flags.BoolVar(&options.Follow, "follow", false, "keep watching the files for new events")
+	flags.DurationVar(&options.PollInterval, "poll-interval", options.PollInterval, "how often followed files are checked for new events")
+	flags.IntVar(&options.BatchSize, "batch-size", options.BatchSize, "number of audit events written at once")
+	flags.StringVar(&options.Cluster, "cluster", cfg.Ingestion.DefaultCluster, "cluster the audit events are attributed to, the configured defaultCluster by default")
+	flags.Usage = func() {
+		flags.Output().Write([]byte("Usage: ingest-file [flags] <audit log path or glob>...\n"))
+		flags.PrintDefaults()
+	}
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+	processors, err := loadIngestProcessors(&cfg.Ingestion)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("at least one audit log path is required")
+	}
+	if err := ingestion.ValidateClusterName(options.Cluster); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	entClient, _, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+
+	checkpoints, err := logfile.LoadCheckpoints(*checkpointPath)
+	if err != nil {
+		return err
+	}
+
+	service := newIngestionService(entClient, processors)
+	ingester := logfile.NewIngester(service, service, checkpoints, options)
+	err = ingester.Run(ctx, flags.Args())
+	stats := ingester.Stats()
+	log.Printf("read %d files, %d events: %d submitted, %d rejected, %d dropped, %d undecodable",
+		stats.Files, stats.Lines, stats.Submitted, stats.Rejected, stats.Dropped, stats.DecodeErrors)
+	return err
+}
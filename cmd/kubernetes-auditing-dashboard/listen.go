@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/certificate"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/config"
+)
+
+// newTLSConfig returns the TLS configuration serving the certificate files, reloaded
+// while the context is alive, or nil when TLS is disabled
+func newTLSConfig(ctx context.Context, o *config.TLSConfig) (*tls.Config, error) {
+	if !o.Enabled() {
+		return nil, nil
+	}
+	if o.SelfSigned {
+		generated, err := certificate.EnsureSelfSigned(o.CertFile, o.KeyFile, strings.Split(o.SelfSignedHosts, ","))
+		if err != nil {
+			return nil, err
+		}
+		if generated {
+			log.Printf("generated a self-signed certificate at %s, for development only", o.CertFile)
+		}
+	}
+
+	reloader, err := certificate.NewReloader(o.CertFile, o.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	go reloader.Watch(ctx, o.ReloadInterval.Duration)
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}, nil
+}
+
+// listener is one address the server listens on
+type listener struct {
+	name      string
+	addr      string
+	handler   http.Handler
+	tlsConfig *tls.Config
+}
+
+// serveListeners serves every listener until one of them fails or the context is
+// cancelled. The servers then stop accepting connections and wait for in-flight
+// requests until the shutdown context expires, after which they are closed.
+func serveListeners(ctx context.Context, listeners []listener, shutdown func() context.Context) error {
+	errs := make(chan error, len(listeners))
+	servers := make([]*http.Server, 0, len(listeners))
+	for _, l := range listeners {
+		server := &http.Server{Addr: l.addr, Handler: l.handler, TLSConfig: l.tlsConfig}
+		servers = append(servers, server)
+		go func(name string) {
+			log.Printf("serving %s on %s (tls: %t)", name, server.Addr, server.TLSConfig != nil)
+			var err error
+			if server.TLSConfig != nil {
+				// The certificate comes from TLSConfig.GetCertificate
+				err = server.ListenAndServeTLS("", "")
+			} else {
+				err = server.ListenAndServe()
+			}
+			errs <- err
+		}(l.name)
+	}
+
+	var err error
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+	}
+
+	shutdownCtx := shutdown()
+	var wg sync.WaitGroup
+	for _, server := range servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				log.Printf("closing %s with requests in flight: %v", server.Addr, err)
+				server.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// shutdownDeadline starts the deadline of the graceful shutdown the first time its context is asked for
+type shutdownDeadline struct {
+	timeout time.Duration
+	once    sync.Once
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// Context returns the context expiring at the end of the graceful shutdown
+func (d *shutdownDeadline) Context() context.Context {
+	d.once.Do(func() {
+		d.ctx, d.cancel = context.WithTimeout(context.Background(), d.timeout)
+	})
+	return d.ctx
+}
+
+// Cancel releases the resources of the deadline
+func (d *shutdownDeadline) Cancel() {
+	d.Context()
+	d.cancel()
+}
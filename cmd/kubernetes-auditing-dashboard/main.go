@@ -1,132 +1,55 @@
 package main
 
 import (
-	"bytes"
-	"context"
-	"encoding/json"
-	"io"
+	"fmt"
 	"log"
-
-	"entgo.io/ent/dialect"
-	"github.com/99designs/gqlgen/graphql/handler"
-	"github.com/99designs/gqlgen/graphql/playground"
-	"github.com/gin-gonic/gin"
-	_ "github.com/mattn/go-sqlite3"
-	"github.com/strrl/kubernetes-auditing-dashboard/ent"
-	"github.com/strrl/kubernetes-auditing-dashboard/ent/migrate"
-	"github.com/strrl/kubernetes-auditing-dashboard/gql"
-	"k8s.io/apimachinery/pkg/runtime"
-	serializerjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
-	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"os"
+	"strings"
 )
 
-func extractResourceName(event *auditv1.Event) string {
-	// First try objectRef.Name
-	if event.ObjectRef != nil && event.ObjectRef.Name != "" {
-		return event.ObjectRef.Name
-	}
+// command is a subcommand of the dashboard binary
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
 
-	// For resources created with generateName, objectRef.Name is empty
-	// Try to extract from responseObject.metadata.name
-	if event.ResponseObject != nil && event.ResponseObject.Raw != nil {
-		var obj map[string]interface{}
-		if err := json.Unmarshal(event.ResponseObject.Raw, &obj); err == nil {
-			if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
-				if name, ok := metadata["name"].(string); ok && name != "" {
-					return name
-				}
-			}
-		}
+var commands = []command{
+	{name: "serve", description: "serve the audit webhook and the dashboard (default)", run: runServe},
+	{name: "ingest-file", description: "ingest audit log files written by the apiserver log backend", run: runIngestFile},
+	{name: "import", description: "bulk-load a directory of archived audit log files", run: runImport},
+	{name: "dedupe", description: "remove duplicated audit events from an existing database", run: runDedupe},
+	{name: "compress-raw", description: "compress raw payloads stored uncompressed and report the compression ratio", run: runCompressRaw},
+	{name: "backfill", description: "extract user and response columns of events stored by older versions", run: runBackfill},
+	{name: "config", description: "validate the configuration with \"config validate\" and print the effective settings", run: runConfig},
+	{name: "prune", description: "delete audit events expired by the retention policy", run: runPrune},
+	{name: "dead-letters", description: "list the webhook payloads that could not be ingested, or reprocess them", run: runDeadLetters},
+	{name: "rotate-key", description: "re-encrypt raw payloads and dead letters with the primary key of the keyring", run: runRotateKey},
+}
+
+func main() {
+	name, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
 	}
 
-	// Fallback to requestObject.metadata.name
-	if event.RequestObject != nil && event.RequestObject.Raw != nil {
-		var obj map[string]interface{}
-		if err := json.Unmarshal(event.RequestObject.Raw, &obj); err == nil {
-			if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
-				if name, ok := metadata["name"].(string); ok && name != "" {
-					return name
-				}
+	for _, cmd := range commands {
+		if cmd.name == name {
+			if err := cmd.run(args); err != nil {
+				log.Fatal(err)
 			}
+			return
 		}
 	}
 
-	return ""
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
 }
 
-func main() {
-	entClient, err := ent.Open(dialect.SQLite, "file:data.db?_fk=1")
-	if err != nil {
-		log.Fatal(err)
-	}
-	scheme := runtime.NewScheme()
-	if err := auditv1.AddToScheme(scheme); err != nil {
-		log.Fatal(err)
-	}
-	defer entClient.Close()
-	ctx := context.Background()
-	// Run the automatic migration tool to create all schema resources.
-	if err := entClient.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [command] [flags]\n\nCommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", cmd.name, cmd.description)
 	}
-	codec := serializerjson.NewSerializerWithOptions(serializerjson.DefaultMetaFactory, scheme, scheme, serializerjson.SerializerOptions{Yaml: false, Pretty: false, Strict: false})
-	app := gin.Default()
-	apiGroup := app.Group("/api")
-	apiGroup.POST("/audit-webhook", func(c *gin.Context) {
-		requestBody, err := io.ReadAll(c.Request.Body)
-		if err != nil {
-			log.Println(err)
-		}
-		eventList := auditv1.EventList{}
-		_, _, err = codec.Decode(requestBody, nil, &eventList)
-		if err != nil {
-			log.Println(err)
-		}
-
-		var entities []*ent.AuditEventCreate
-		for _, event := range eventList.Items {
-			buffer := bytes.Buffer{}
-			err := codec.Encode(&event, &buffer)
-			if err != nil {
-				log.Fatal(err)
-			}
-			raw, err := io.ReadAll(&buffer)
-			if err != nil {
-				log.Fatal(err)
-			}
-			item := entClient.AuditEvent.Create().
-				SetStage(string(event.Stage)).
-				SetAuditID(string(event.AuditID)).
-				SetVerb(event.Verb).
-				SetUserAgent(event.UserAgent).
-				SetLevel(string(event.Level)).
-				SetRequestTimestamp(event.RequestReceivedTimestamp.Time).
-				SetStageTimestamp(event.StageTimestamp.Time).
-				SetRaw(string(raw))
-
-			if event.ObjectRef != nil {
-				resourceName := extractResourceName(&event)
-				item.SetNamespace(event.ObjectRef.Namespace).
-					SetName(resourceName).
-					SetApiVersion(event.ObjectRef.APIVersion).
-					SetApiGroup(event.ObjectRef.APIGroup).
-					SetResource(event.ObjectRef.Resource).
-					SetSubResource(event.ObjectRef.Subresource)
-			}
-			entities = append(entities, item)
-		}
-		_, err = entClient.AuditEvent.CreateBulk(entities...).Save(ctx)
-		if err != nil {
-			log.Fatal(err)
-		}
-		println(len(eventList.Items))
-		c.Status(200)
-	})
-	apiGroup.GET("/playground", gin.WrapF(playground.Handler("", "/api/query")))
-	graphqlServer := handler.NewDefaultServer(gql.NewExecutableSchema(
-		gql.Config{
-			Resolvers: gql.NewResolver(entClient),
-		}))
-	apiGroup.Any("/query", gin.WrapH(graphqlServer))
-	app.Run("0.0.0.0:23333")
 }
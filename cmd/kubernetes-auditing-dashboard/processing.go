@@ -0,0 +1,95 @@
+package main
+
+import (
+	"log"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/config"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/redaction"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+)
+
+// ingestProcessors are run on every ingested event: the filter first, so that
+// dropped events are neither sampled nor redacted, then the sampler, so that
+// events sampled out are not redacted either, then the redactor
+type ingestProcessors struct {
+	filter   *auditfilter.Filter
+	sampler  *sampling.Sampler
+	redactor *redaction.Redactor
+}
+
+// loadIngestProcessors reads the filter policy and the redaction key, both optional
+func loadIngestProcessors(cfg *config.IngestionConfig) (*ingestProcessors, error) {
+	processors := &ingestProcessors{}
+	if cfg.FilterPolicy != "" {
+		filter, err := auditfilter.LoadFilter(cfg.FilterPolicy)
+		if err != nil {
+			return nil, err
+		}
+		processors.filter = filter
+	}
+	if cfg.Sampling.Enabled() {
+		processors.sampler = sampling.NewSampler(cfg.Sampling)
+	}
+	if !cfg.Redaction.Disabled {
+		key, created, err := redaction.LoadOrCreateKey(cfg.Redaction.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			log.Printf("generated redaction key %s, keep it to compare redacted values across restarts", cfg.Redaction.KeyFile)
+		}
+		redactor, err := redaction.NewRedactor(key, cfg.Redaction.Rules)
+		if err != nil {
+			return nil, err
+		}
+		processors.redactor = redactor
+	}
+	return processors, nil
+}
+
+// checkIngestProcessors checks the filter policy and the redaction key like
+// loadIngestProcessors, without generating the key when it does not exist
+func checkIngestProcessors(cfg *config.IngestionConfig) error {
+	if cfg.FilterPolicy != "" {
+		if _, err := auditfilter.LoadFilter(cfg.FilterPolicy); err != nil {
+			return err
+		}
+	}
+	if !cfg.Redaction.Disabled {
+		return redaction.CheckKey(cfg.Redaction.KeyFile)
+	}
+	return nil
+}
+
+// loadDryRunProcessors checks the processors like checkIngestProcessors and
+// loads those selecting the events, leaving out the redactor: it only rewrites
+// events, and loading it would generate a missing redaction key
+func loadDryRunProcessors(cfg *config.IngestionConfig) (*ingestProcessors, error) {
+	if err := checkIngestProcessors(cfg); err != nil {
+		return nil, err
+	}
+	selection := *cfg
+	selection.Redaction.Disabled = true
+	return loadIngestProcessors(&selection)
+}
+
+// newIngestionService creates the ingestion service running the configured processors
+func newIngestionService(client *ent.Client, processors *ingestProcessors, opts ...ingestion.Option) *ingestion.Service {
+	var chain []ingestion.Processor
+	if processors.filter != nil {
+		chain = append(chain, processors.filter)
+	}
+	if processors.sampler != nil {
+		chain = append(chain, processors.sampler)
+	}
+	if processors.redactor != nil {
+		chain = append(chain, processors.redactor)
+	}
+	if len(chain) > 0 {
+		opts = append(opts, ingestion.WithProcessors(chain...))
+	}
+	return ingestion.NewService(client, opts...)
+}
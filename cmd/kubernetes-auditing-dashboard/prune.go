@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+)
+
+// runPrune deletes the audit events expired by the retention policy
+func runPrune(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("prune", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.Retention.AddPolicyFlags(flags)
+	options := cfg.Retention.PrunerOptions()
+	flags.IntVar(&options.BatchSize, "batch-size", options.BatchSize, "number of events deleted per transaction")
+	flags.DurationVar(&options.BatchPause, "batch-pause", options.BatchPause, "pause between two deleted batches")
+	dryRun := flags.Bool("dry-run", false, "only report how many events each rule would delete")
+	vacuum := flags.Bool("vacuum", false, "VACUUM a SQLite database after pruning to shrink the file")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	policy, err := cfg.Retention.LoadPolicy()
+	if err != nil {
+		return err
+	}
+	if !policy.Enabled() {
+		log.Print("the retention policy keeps every event, set -retention-policy or -retention-default-ttl")
+		return nil
+	}
+
+	entClient, driver, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	ctx := context.Background()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+
+	// The command decides on VACUUM itself instead of the pruner schedule
+	options.VacuumInterval = 0
+	report, err := retention.NewPruner(entClient, driver, policy, options).Prune(ctx, *dryRun)
+	if report != nil {
+		for _, rule := range report.Rules {
+			if *dryRun {
+				log.Printf("rule %s (ttl %s): %d events would be deleted", rule.Rule, rule.TTL, rule.Expired)
+			} else {
+				log.Printf("rule %s (ttl %s): deleted %d events", rule.Rule, rule.TTL, rule.Expired)
+			}
+		}
+	}
+	if err != nil || *dryRun || !*vacuum {
+		return err
+	}
+
+	start := time.Now()
+	if err := retention.Vacuum(ctx, driver); err != nil {
+		return err
+	}
+	log.Printf("vacuumed the database in %s", time.Since(start).Round(time.Millisecond))
+	return nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+// runRotateKey re-encrypts the raw payloads not encrypted with the primary key
+// of the keyring, optionally generating a new primary key first
+func runRotateKey(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("rotate-key", flag.ExitOnError)
+	cfg.Database.AddFlags(flags)
+	cfg.RawCompression.AddFlags(flags)
+	cfg.RawEncryption.AddFlags(flags)
+	generate := flags.Bool("generate", false, "add a new primary key to the keyring, creating the keyring when missing")
+	batchSize := flags.Int("batch-size", ingestion.DefaultReencryptBatchSize, "number of events re-encrypted per transaction")
+	statsOnly := flags.Bool("stats", false, "only report the number of events encrypted with each key")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if cfg.RawEncryption.Keyring == "" {
+		return errors.New("-raw-encryption-keyring is required")
+	}
+	if *generate {
+		if err := generateKey(cfg.RawEncryption.Keyring); err != nil {
+			return err
+		}
+	}
+	if err := applyRawCodec(cfg); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	entClient, _, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+
+	if !*statsOnly {
+		reencrypted, err := ingestion.ReencryptRaw(ctx, entClient, *batchSize)
+		log.Printf("re-encrypted %d audit events with key %s", reencrypted, rawcodec.KeyID())
+		if err != nil {
+			return err
+		}
+		letters, err := ingestion.ReencryptDeadLetters(ctx, entClient)
+		log.Printf("re-encrypted %d dead letters with key %s", letters, rawcodec.KeyID())
+		if err != nil {
+			return err
+		}
+	}
+
+	counts, err := ingestion.CountRawKeys(ctx, entClient)
+	if err != nil {
+		return err
+	}
+	keyIDs := make([]string, 0, len(counts))
+	for keyID := range counts {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+	for _, keyID := range keyIDs {
+		if keyID == "" {
+			log.Printf("%d events not encrypted", counts[keyID])
+		} else {
+			log.Printf("%d events encrypted with key %s", counts[keyID], keyID)
+		}
+	}
+	return nil
+}
+
+// generateKey adds a new primary key to the keyring file, creating it when missing
+func generateKey(path string) error {
+	keyring, err := rawcodec.LoadKeyring(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		keyring, err = &rawcodec.Keyring{}, nil
+	}
+	if err != nil {
+		return err
+	}
+	key, err := keyring.Generate()
+	if err != nil {
+		return err
+	}
+	if err := keyring.Save(path); err != nil {
+		return err
+	}
+	log.Printf("added primary key %s to %s", key.ID, path)
+	return nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gin-gonic/gin"
+	"github.com/strrl/kubernetes-auditing-dashboard/gql"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/forwarding"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/health"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/metrics"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+)
+
+func runServe(args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	cfg.AddFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	deps, err := loadServeDependencies(cfg)
+	if err != nil {
+		return err
+	}
+	authenticator := deps.authenticator
+	if !authenticator.Enabled() {
+		log.Print("warning: the audit webhook accepts unauthenticated requests (-webhook-allow-anonymous)")
+	}
+	logEffectiveConfig(cfg)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	entClient, driver, err := openDatabase(cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+	defer entClient.Close()
+	if err := migrateDatabase(ctx, entClient); err != nil {
+		return err
+	}
+	if deps.retentionPolicy.Enabled() {
+		go retention.NewPruner(entClient, driver, deps.retentionPolicy, cfg.Retention.PrunerOptions()).Run(ctx)
+	}
+
+	tlsConfig, err := newTLSConfig(ctx, &cfg.TLS)
+	if err != nil {
+		return err
+	}
+
+	registry := metrics.NewRegistry()
+	registry.MustRegister(
+		metrics.NewDatabase(entClient, driver, metrics.DefaultDatabaseRefreshInterval),
+		metrics.NewIngestion(authenticator, deps.processors.filter, deps.processors.sampler),
+	)
+	ingestionOptions := []ingestion.Option{
+		ingestion.WithDefaultCluster(cfg.Ingestion.DefaultCluster),
+		ingestion.WithMaxBodySize(cfg.Ingestion.MaxBodySize),
+		ingestion.WithMetrics(ingestion.NewMetrics(registry)),
+	}
+	if !cfg.Ingestion.DeadLettersDisabled {
+		ingestionOptions = append(ingestionOptions, ingestion.WithDeadLetters())
+		// Undecodable bodies cannot be redacted, only encryption protects them
+		if !cfg.Ingestion.Redaction.Disabled && cfg.RawEncryption.Keyring == "" {
+			log.Printf("undecodable webhook bodies are not kept as dead letters: they cannot be redacted and raw-encryption-keyring is not set")
+			ingestionOptions = append(ingestionOptions, ingestion.WithoutDeadLetterBodies())
+		}
+	}
+	if deps.forwarder != nil {
+		ingestionOptions = append(ingestionOptions, ingestion.WithForwarder(deps.forwarder))
+		forwarding.RegisterMetrics(registry, deps.forwarder)
+		go deps.forwarder.Run(ctx)
+	}
+	ingestionService := newIngestionService(entClient, deps.processors, ingestionOptions...)
+	checker := health.NewChecker()
+	checker.AddReadinessCheck("database", func(ctx context.Context) error { return database.Ping(ctx, driver) })
+	app := gin.Default()
+	app.GET("/metrics", metrics.NewHandler(registry))
+	apiGroup := app.Group("/api")
+	webhookApp := app
+	if cfg.Listen.WebhookAddress != "" {
+		webhookApp = gin.Default()
+	}
+	engines := []*gin.Engine{app}
+	if webhookApp != app {
+		engines = append(engines, webhookApp)
+	}
+	for _, engine := range engines {
+		engine.GET("/healthz", checker.LivenessHandler())
+		engine.GET("/readyz", checker.ReadinessHandler())
+	}
+	webhookAPIGroup := webhookApp.Group("/api")
+	var sink ingestion.Sink = ingestionService
+	var queue *ingestion.Queue
+	if queueOptions := cfg.Ingestion.QueueOptions(); queueOptions.Workers > 0 {
+		queue = ingestion.NewQueue(ingestionService, queueOptions)
+		queue.Start()
+		checker.AddReadinessCheck("ingestion-queue", func(context.Context) error { return queue.Ready() })
+		sink = queue
+		apiGroup.GET("/ingestion/stats", ingestion.NewStatsHandler(queue))
+		ingestion.RegisterQueueMetrics(registry, queue)
+	}
+	webhookHandler := ingestion.NewWebhookHandler(ingestionService, sink)
+	apiGroup.GET("/ingestion/auth/stats", ingestion.NewAuthStatsHandler(authenticator))
+	if deps.forwarder != nil {
+		apiGroup.GET("/forwarding/stats", forwarding.NewStatsHandler(deps.forwarder))
+	}
+	if deps.processors.filter != nil {
+		apiGroup.GET("/ingestion/filter/stats", auditfilter.NewStatsHandler(deps.processors.filter))
+	}
+	if deps.processors.sampler != nil {
+		apiGroup.GET("/ingestion/sampling/stats", sampling.NewStatsHandler(deps.processors.sampler))
+	}
+	webhookGroup := webhookAPIGroup.Group("/audit-webhook", authenticator.Middleware())
+	webhookGroup.POST("", webhookHandler)
+	webhookGroup.POST("/:cluster", webhookHandler)
+	apiGroup.GET("/playground", gin.WrapF(playground.Handler("", "/api/query")))
+	graphqlServer := handler.NewDefaultServer(gql.NewExecutableSchema(
+		gql.Config{
+			Resolvers: gql.NewResolver(entClient, gql.WithDefaultPageSize(cfg.GraphQL.DefaultPageSize)),
+		}))
+	graphqlServer.Use(metrics.NewGraphQL(registry))
+	apiGroup.Any("/query", gin.WrapH(graphqlServer))
+
+	listeners := []listener{{name: "dashboard", addr: cfg.Listen.Address, handler: app, tlsConfig: tlsConfig}}
+	if cfg.Listen.WebhookAddress != "" {
+		listeners = append(listeners, listener{name: "webhook", addr: cfg.Listen.WebhookAddress, handler: webhookApp, tlsConfig: tlsConfig})
+	}
+	if tlsConfig != nil && authenticator.RequiresClientCertificates() {
+		// Client certificates are only requested where the webhook is served
+		webhookTLS := tlsConfig.Clone()
+		webhookTLS.ClientAuth = tls.RequestClientCert
+		listeners[len(listeners)-1].tlsConfig = webhookTLS
+	}
+
+	// Readiness fails as soon as a signal arrives so that load balancers stop
+	// sending requests while the in-flight ones finish
+	go func() {
+		<-ctx.Done()
+		checker.SetShuttingDown()
+		log.Printf("shutting down, waiting up to %s for in-flight requests and buffered audit events", cfg.Listen.ShutdownTimeout.Duration)
+	}()
+	deadline := &shutdownDeadline{timeout: cfg.Listen.ShutdownTimeout.Duration}
+	defer deadline.Cancel()
+	err = serveListeners(ctx, listeners, deadline.Context)
+	if queue != nil {
+		// The listeners are closed, so nothing is submitted anymore
+		if stopErr := queue.Stop(deadline.Context()); stopErr != nil {
+			log.Printf("shutdown deadline exceeded with %d audit events not written: %v", queue.Stats().Depth, stopErr)
+		}
+	}
+	return err
+}
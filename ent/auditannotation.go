@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+)
+
+// AuditAnnotation is the model entity for the AuditAnnotation schema.
+type AuditAnnotation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuditAnnotationQuery when eager-loading is set.
+	Edges                   AuditAnnotationEdges `json:"edges"`
+	audit_event_annotations *int
+	selectValues            sql.SelectValues
+}
+
+// AuditAnnotationEdges holds the relations/edges for other nodes in the graph.
+type AuditAnnotationEdges struct {
+	// Event holds the value of the event edge.
+	Event *AuditEvent `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuditAnnotationEdges) EventOrErr() (*AuditEvent, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: auditevent.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditAnnotation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditannotation.FieldID:
+			values[i] = new(sql.NullInt64)
+		case auditannotation.FieldKey, auditannotation.FieldValue:
+			values[i] = new(sql.NullString)
+		case auditannotation.ForeignKeys[0]: // audit_event_annotations
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditAnnotation fields.
+func (_m *AuditAnnotation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditannotation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case auditannotation.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case auditannotation.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case auditannotation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field audit_event_annotations", value)
+			} else if value.Valid {
+				_m.audit_event_annotations = new(int)
+				*_m.audit_event_annotations = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the AuditAnnotation.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditAnnotation) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the AuditAnnotation entity.
+func (_m *AuditAnnotation) QueryEvent() *AuditEventQuery {
+	return NewAuditAnnotationClient(_m.config).QueryEvent(_m)
+}
+
+// Update returns a builder for updating this AuditAnnotation.
+// Note that you need to call AuditAnnotation.Unwrap() before calling this method if this AuditAnnotation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditAnnotation) Update() *AuditAnnotationUpdateOne {
+	return NewAuditAnnotationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditAnnotation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditAnnotation) Unwrap() *AuditAnnotation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditAnnotation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditAnnotation) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditAnnotation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditAnnotations is a parsable slice of AuditAnnotation.
+type AuditAnnotations []*AuditAnnotation
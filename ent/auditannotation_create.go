@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+)
+
+// AuditAnnotationCreate is the builder for creating a AuditAnnotation entity.
+type AuditAnnotationCreate struct {
+	config
+	mutation *AuditAnnotationMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *AuditAnnotationCreate) SetKey(v string) *AuditAnnotationCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *AuditAnnotationCreate) SetValue(v string) *AuditAnnotationCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *AuditAnnotationCreate) SetNillableValue(v *string) *AuditAnnotationCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event" edge to the AuditEvent entity by ID.
+func (_c *AuditAnnotationCreate) SetEventID(id int) *AuditAnnotationCreate {
+	_c.mutation.SetEventID(id)
+	return _c
+}
+
+// SetEvent sets the "event" edge to the AuditEvent entity.
+func (_c *AuditAnnotationCreate) SetEvent(v *AuditEvent) *AuditAnnotationCreate {
+	return _c.SetEventID(v.ID)
+}
+
+// Mutation returns the AuditAnnotationMutation object of the builder.
+func (_c *AuditAnnotationCreate) Mutation() *AuditAnnotationMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditAnnotation in the database.
+func (_c *AuditAnnotationCreate) Save(ctx context.Context) (*AuditAnnotation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditAnnotationCreate) SaveX(ctx context.Context) *AuditAnnotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditAnnotationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditAnnotationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditAnnotationCreate) defaults() {
+	if _, ok := _c.mutation.Value(); !ok {
+		v := auditannotation.DefaultValue
+		_c.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditAnnotationCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "AuditAnnotation.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := auditannotation.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AuditAnnotation.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "AuditAnnotation.value"`)}
+	}
+	if len(_c.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "AuditAnnotation.event"`)}
+	}
+	return nil
+}
+
+func (_c *AuditAnnotationCreate) sqlSave(ctx context.Context) (*AuditAnnotation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditAnnotationCreate) createSpec() (*AuditAnnotation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditAnnotation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditannotation.Table, sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(auditannotation.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(auditannotation.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditannotation.EventTable,
+			Columns: []string{auditannotation.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.audit_event_annotations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuditAnnotationCreateBulk is the builder for creating many AuditAnnotation entities in bulk.
+type AuditAnnotationCreateBulk struct {
+	config
+	err      error
+	builders []*AuditAnnotationCreate
+}
+
+// Save creates the AuditAnnotation entities in the database.
+func (_c *AuditAnnotationCreateBulk) Save(ctx context.Context) ([]*AuditAnnotation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditAnnotation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditAnnotationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditAnnotationCreateBulk) SaveX(ctx context.Context) []*AuditAnnotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditAnnotationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditAnnotationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+)
+
+// AuditAnnotationDelete is the builder for deleting a AuditAnnotation entity.
+type AuditAnnotationDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditAnnotationMutation
+}
+
+// Where appends a list predicates to the AuditAnnotationDelete builder.
+func (_d *AuditAnnotationDelete) Where(ps ...predicate.AuditAnnotation) *AuditAnnotationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AuditAnnotationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AuditAnnotationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AuditAnnotationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(auditannotation.Table, sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AuditAnnotationDeleteOne is the builder for deleting a single AuditAnnotation entity.
+type AuditAnnotationDeleteOne struct {
+	_d *AuditAnnotationDelete
+}
+
+// Where appends a list predicates to the AuditAnnotationDelete builder.
+func (_d *AuditAnnotationDeleteOne) Where(ps ...predicate.AuditAnnotation) *AuditAnnotationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AuditAnnotationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditannotation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AuditAnnotationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
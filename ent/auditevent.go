@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -44,21 +45,70 @@ type AuditEvent struct {
 	// SubResource holds the value of the "subResource" field.
 	SubResource string `json:"subResource,omitempty"`
 	// Stage holds the value of the "stage" field.
-	Stage        string `json:"stage,omitempty"`
+	Stage string `json:"stage,omitempty"`
+	// Cluster holds the value of the "cluster" field.
+	Cluster string `json:"cluster,omitempty"`
+	// SampleWeight holds the value of the "sampleWeight" field.
+	SampleWeight int `json:"sampleWeight,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// UserGroups holds the value of the "userGroups" field.
+	UserGroups []string `json:"userGroups,omitempty"`
+	// SourceIPs holds the value of the "sourceIPs" field.
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+	// ResponseCode holds the value of the "responseCode" field.
+	ResponseCode int `json:"responseCode,omitempty"`
+	// ImpersonatedUser holds the value of the "impersonatedUser" field.
+	ImpersonatedUser string `json:"impersonatedUser,omitempty"`
+	// UserAgentName holds the value of the "userAgentName" field.
+	UserAgentName string `json:"userAgentName,omitempty"`
+	// RawSize holds the value of the "rawSize" field.
+	RawSize int `json:"rawSize,omitempty"`
+	// RawKeyID holds the value of the "rawKeyID" field.
+	RawKeyID string `json:"rawKeyID,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuditEventQuery when eager-loading is set.
+	Edges        AuditEventEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// AuditEventEdges holds the relations/edges for other nodes in the graph.
+type AuditEventEdges struct {
+	// Annotations holds the value of the annotations edge.
+	Annotations []*AuditAnnotation `json:"annotations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedAnnotations map[string][]*AuditAnnotation
+}
+
+// AnnotationsOrErr returns the Annotations value or an error if the edge
+// was not loaded in eager-loading.
+func (e AuditEventEdges) AnnotationsOrErr() ([]*AuditAnnotation, error) {
+	if e.loadedTypes[0] {
+		return e.Annotations, nil
+	}
+	return nil, &NotLoadedError{edge: "annotations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AuditEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case auditevent.FieldID:
+		case auditevent.FieldUserGroups, auditevent.FieldSourceIPs:
+			values[i] = new([]byte)
+		case auditevent.FieldID, auditevent.FieldSampleWeight, auditevent.FieldResponseCode, auditevent.FieldRawSize:
 			values[i] = new(sql.NullInt64)
-		case auditevent.FieldRaw, auditevent.FieldLevel, auditevent.FieldAuditID, auditevent.FieldVerb, auditevent.FieldUserAgent, auditevent.FieldNamespace, auditevent.FieldName, auditevent.FieldApiVersion, auditevent.FieldApiGroup, auditevent.FieldResource, auditevent.FieldSubResource, auditevent.FieldStage:
+		case auditevent.FieldLevel, auditevent.FieldAuditID, auditevent.FieldVerb, auditevent.FieldUserAgent, auditevent.FieldNamespace, auditevent.FieldName, auditevent.FieldApiVersion, auditevent.FieldApiGroup, auditevent.FieldResource, auditevent.FieldSubResource, auditevent.FieldStage, auditevent.FieldCluster, auditevent.FieldUsername, auditevent.FieldImpersonatedUser, auditevent.FieldUserAgentName, auditevent.FieldRawKeyID:
 			values[i] = new(sql.NullString)
 		case auditevent.FieldRequestTimestamp, auditevent.FieldStageTimestamp:
 			values[i] = new(sql.NullTime)
+		case auditevent.FieldRaw:
+			values[i] = auditevent.ValueScanner.Raw.ScanValue()
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -81,10 +131,10 @@ func (_m *AuditEvent) assignValues(columns []string, values []any) error {
 			}
 			_m.ID = int(value.Int64)
 		case auditevent.FieldRaw:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field raw", values[i])
-			} else if value.Valid {
-				_m.Raw = value.String
+			if value, err := auditevent.ValueScanner.Raw.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.Raw = value
 			}
 		case auditevent.FieldLevel:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -164,6 +214,70 @@ func (_m *AuditEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Stage = value.String
 			}
+		case auditevent.FieldCluster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster", values[i])
+			} else if value.Valid {
+				_m.Cluster = value.String
+			}
+		case auditevent.FieldSampleWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sampleWeight", values[i])
+			} else if value.Valid {
+				_m.SampleWeight = int(value.Int64)
+			}
+		case auditevent.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case auditevent.FieldUserGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field userGroups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UserGroups); err != nil {
+					return fmt.Errorf("unmarshal field userGroups: %w", err)
+				}
+			}
+		case auditevent.FieldSourceIPs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sourceIPs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SourceIPs); err != nil {
+					return fmt.Errorf("unmarshal field sourceIPs: %w", err)
+				}
+			}
+		case auditevent.FieldResponseCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field responseCode", values[i])
+			} else if value.Valid {
+				_m.ResponseCode = int(value.Int64)
+			}
+		case auditevent.FieldImpersonatedUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonatedUser", values[i])
+			} else if value.Valid {
+				_m.ImpersonatedUser = value.String
+			}
+		case auditevent.FieldUserAgentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userAgentName", values[i])
+			} else if value.Valid {
+				_m.UserAgentName = value.String
+			}
+		case auditevent.FieldRawSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rawSize", values[i])
+			} else if value.Valid {
+				_m.RawSize = int(value.Int64)
+			}
+		case auditevent.FieldRawKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rawKeyID", values[i])
+			} else if value.Valid {
+				_m.RawKeyID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +291,11 @@ func (_m *AuditEvent) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryAnnotations queries the "annotations" edge of the AuditEvent entity.
+func (_m *AuditEvent) QueryAnnotations() *AuditAnnotationQuery {
+	return NewAuditEventClient(_m.config).QueryAnnotations(_m)
+}
+
 // Update returns a builder for updating this AuditEvent.
 // Note that you need to call AuditEvent.Unwrap() before calling this method if this AuditEvent
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -241,9 +360,63 @@ func (_m *AuditEvent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("stage=")
 	builder.WriteString(_m.Stage)
+	builder.WriteString(", ")
+	builder.WriteString("cluster=")
+	builder.WriteString(_m.Cluster)
+	builder.WriteString(", ")
+	builder.WriteString("sampleWeight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SampleWeight))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("userGroups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserGroups))
+	builder.WriteString(", ")
+	builder.WriteString("sourceIPs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceIPs))
+	builder.WriteString(", ")
+	builder.WriteString("responseCode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResponseCode))
+	builder.WriteString(", ")
+	builder.WriteString("impersonatedUser=")
+	builder.WriteString(_m.ImpersonatedUser)
+	builder.WriteString(", ")
+	builder.WriteString("userAgentName=")
+	builder.WriteString(_m.UserAgentName)
+	builder.WriteString(", ")
+	builder.WriteString("rawSize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RawSize))
+	builder.WriteString(", ")
+	builder.WriteString("rawKeyID=")
+	builder.WriteString(_m.RawKeyID)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedAnnotations returns the Annotations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *AuditEvent) NamedAnnotations(name string) ([]*AuditAnnotation, error) {
+	if _m.Edges.namedAnnotations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAnnotations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *AuditEvent) appendNamedAnnotations(name string, edges ...*AuditAnnotation) {
+	if _m.Edges.namedAnnotations == nil {
+		_m.Edges.namedAnnotations = make(map[string][]*AuditAnnotation)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAnnotations[name] = []*AuditAnnotation{}
+	} else {
+		_m.Edges.namedAnnotations[name] = append(_m.Edges.namedAnnotations[name], edges...)
+	}
+}
+
 // AuditEvents is a parsable slice of AuditEvent.
 type AuditEvents []*AuditEvent
@@ -4,6 +4,8 @@ package auditevent
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -39,8 +41,37 @@ const (
 	FieldSubResource = "sub_resource"
 	// FieldStage holds the string denoting the stage field in the database.
 	FieldStage = "stage"
+	// FieldCluster holds the string denoting the cluster field in the database.
+	FieldCluster = "cluster"
+	// FieldSampleWeight holds the string denoting the sampleweight field in the database.
+	FieldSampleWeight = "sample_weight"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldUserGroups holds the string denoting the usergroups field in the database.
+	FieldUserGroups = "user_groups"
+	// FieldSourceIPs holds the string denoting the sourceips field in the database.
+	FieldSourceIPs = "source_ips"
+	// FieldResponseCode holds the string denoting the responsecode field in the database.
+	FieldResponseCode = "response_code"
+	// FieldImpersonatedUser holds the string denoting the impersonateduser field in the database.
+	FieldImpersonatedUser = "impersonated_user"
+	// FieldUserAgentName holds the string denoting the useragentname field in the database.
+	FieldUserAgentName = "user_agent_name"
+	// FieldRawSize holds the string denoting the rawsize field in the database.
+	FieldRawSize = "raw_size"
+	// FieldRawKeyID holds the string denoting the rawkeyid field in the database.
+	FieldRawKeyID = "raw_key_id"
+	// EdgeAnnotations holds the string denoting the annotations edge name in mutations.
+	EdgeAnnotations = "annotations"
 	// Table holds the table name of the auditevent in the database.
 	Table = "audit_events"
+	// AnnotationsTable is the table that holds the annotations relation/edge.
+	AnnotationsTable = "audit_annotations"
+	// AnnotationsInverseTable is the table name for the AuditAnnotation entity.
+	// It exists in this package in order to avoid circular dependency with the "auditannotation" package.
+	AnnotationsInverseTable = "audit_annotations"
+	// AnnotationsColumn is the table column denoting the annotations relation/edge.
+	AnnotationsColumn = "audit_event_annotations"
 )
 
 // Columns holds all SQL columns for auditevent fields.
@@ -60,6 +91,16 @@ var Columns = []string{
 	FieldResource,
 	FieldSubResource,
 	FieldStage,
+	FieldCluster,
+	FieldSampleWeight,
+	FieldUsername,
+	FieldUserGroups,
+	FieldSourceIPs,
+	FieldResponseCode,
+	FieldImpersonatedUser,
+	FieldUserAgentName,
+	FieldRawSize,
+	FieldRawKeyID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,6 +136,26 @@ var (
 	DefaultResource string
 	// DefaultSubResource holds the default value on creation for the "subResource" field.
 	DefaultSubResource string
+	// DefaultCluster holds the default value on creation for the "cluster" field.
+	DefaultCluster string
+	// DefaultSampleWeight holds the default value on creation for the "sampleWeight" field.
+	DefaultSampleWeight int
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// DefaultResponseCode holds the default value on creation for the "responseCode" field.
+	DefaultResponseCode int
+	// DefaultImpersonatedUser holds the default value on creation for the "impersonatedUser" field.
+	DefaultImpersonatedUser string
+	// DefaultUserAgentName holds the default value on creation for the "userAgentName" field.
+	DefaultUserAgentName string
+	// DefaultRawSize holds the default value on creation for the "rawSize" field.
+	DefaultRawSize int
+	// DefaultRawKeyID holds the default value on creation for the "rawKeyID" field.
+	DefaultRawKeyID string
+	// ValueScanner of all AuditEvent fields.
+	ValueScanner struct {
+		Raw field.TypeValueScanner[string]
+	}
 )
 
 // OrderOption defines the ordering options for the AuditEvent queries.
@@ -174,3 +235,64 @@ func BySubResource(opts ...sql.OrderTermOption) OrderOption {
 func ByStage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStage, opts...).ToFunc()
 }
+
+// ByCluster orders the results by the cluster field.
+func ByCluster(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCluster, opts...).ToFunc()
+}
+
+// BySampleWeight orders the results by the sampleWeight field.
+func BySampleWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSampleWeight, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByResponseCode orders the results by the responseCode field.
+func ByResponseCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCode, opts...).ToFunc()
+}
+
+// ByImpersonatedUser orders the results by the impersonatedUser field.
+func ByImpersonatedUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatedUser, opts...).ToFunc()
+}
+
+// ByUserAgentName orders the results by the userAgentName field.
+func ByUserAgentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgentName, opts...).ToFunc()
+}
+
+// ByRawSize orders the results by the rawSize field.
+func ByRawSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawSize, opts...).ToFunc()
+}
+
+// ByRawKeyID orders the results by the rawKeyID field.
+func ByRawKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawKeyID, opts...).ToFunc()
+}
+
+// ByAnnotationsCount orders the results by annotations count.
+func ByAnnotationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnnotationsStep(), opts...)
+	}
+}
+
+// ByAnnotations orders the results by annotations terms.
+func ByAnnotations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnnotationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAnnotationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnnotationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnnotationsTable, AnnotationsColumn),
+	)
+}
@@ -3,9 +3,11 @@
 package auditevent
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
 )
 
@@ -56,7 +58,8 @@ func IDLTE(id int) predicate.AuditEvent {
 
 // Raw applies equality check predicate on the "raw" field. It's identical to RawEQ.
 func Raw(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldEQ(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldEQ(FieldRaw, vc), err)
 }
 
 // Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
@@ -124,69 +127,158 @@ func Stage(v string) predicate.AuditEvent {
 	return predicate.AuditEvent(sql.FieldEQ(FieldStage, v))
 }
 
+// Cluster applies equality check predicate on the "cluster" field. It's identical to ClusterEQ.
+func Cluster(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldCluster, v))
+}
+
+// SampleWeight applies equality check predicate on the "sampleWeight" field. It's identical to SampleWeightEQ.
+func SampleWeight(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldSampleWeight, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldUsername, v))
+}
+
+// ResponseCode applies equality check predicate on the "responseCode" field. It's identical to ResponseCodeEQ.
+func ResponseCode(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldResponseCode, v))
+}
+
+// ImpersonatedUser applies equality check predicate on the "impersonatedUser" field. It's identical to ImpersonatedUserEQ.
+func ImpersonatedUser(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldImpersonatedUser, v))
+}
+
+// UserAgentName applies equality check predicate on the "userAgentName" field. It's identical to UserAgentNameEQ.
+func UserAgentName(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldUserAgentName, v))
+}
+
+// RawSize applies equality check predicate on the "rawSize" field. It's identical to RawSizeEQ.
+func RawSize(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldRawSize, v))
+}
+
+// RawKeyID applies equality check predicate on the "rawKeyID" field. It's identical to RawKeyIDEQ.
+func RawKeyID(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldRawKeyID, v))
+}
+
 // RawEQ applies the EQ predicate on the "raw" field.
 func RawEQ(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldEQ(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldEQ(FieldRaw, vc), err)
 }
 
 // RawNEQ applies the NEQ predicate on the "raw" field.
 func RawNEQ(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldNEQ(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldNEQ(FieldRaw, vc), err)
 }
 
 // RawIn applies the In predicate on the "raw" field.
 func RawIn(vs ...string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldIn(FieldRaw, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Raw.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.AuditEventOrErr(sql.FieldIn(FieldRaw, v...), err)
 }
 
 // RawNotIn applies the NotIn predicate on the "raw" field.
 func RawNotIn(vs ...string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldNotIn(FieldRaw, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Raw.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.AuditEventOrErr(sql.FieldNotIn(FieldRaw, v...), err)
 }
 
 // RawGT applies the GT predicate on the "raw" field.
 func RawGT(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldGT(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldGT(FieldRaw, vc), err)
 }
 
 // RawGTE applies the GTE predicate on the "raw" field.
 func RawGTE(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldGTE(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldGTE(FieldRaw, vc), err)
 }
 
 // RawLT applies the LT predicate on the "raw" field.
 func RawLT(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldLT(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldLT(FieldRaw, vc), err)
 }
 
 // RawLTE applies the LTE predicate on the "raw" field.
 func RawLTE(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldLTE(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	return predicate.AuditEventOrErr(sql.FieldLTE(FieldRaw, vc), err)
 }
 
 // RawContains applies the Contains predicate on the "raw" field.
 func RawContains(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldContains(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("raw value is not a string: %T", vc)
+	}
+	return predicate.AuditEventOrErr(sql.FieldContains(FieldRaw, vcs), err)
 }
 
 // RawHasPrefix applies the HasPrefix predicate on the "raw" field.
 func RawHasPrefix(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldHasPrefix(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("raw value is not a string: %T", vc)
+	}
+	return predicate.AuditEventOrErr(sql.FieldHasPrefix(FieldRaw, vcs), err)
 }
 
 // RawHasSuffix applies the HasSuffix predicate on the "raw" field.
 func RawHasSuffix(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldHasSuffix(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("raw value is not a string: %T", vc)
+	}
+	return predicate.AuditEventOrErr(sql.FieldHasSuffix(FieldRaw, vcs), err)
 }
 
 // RawEqualFold applies the EqualFold predicate on the "raw" field.
 func RawEqualFold(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldEqualFold(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("raw value is not a string: %T", vc)
+	}
+	return predicate.AuditEventOrErr(sql.FieldEqualFold(FieldRaw, vcs), err)
 }
 
 // RawContainsFold applies the ContainsFold predicate on the "raw" field.
 func RawContainsFold(v string) predicate.AuditEvent {
-	return predicate.AuditEvent(sql.FieldContainsFold(FieldRaw, v))
+	vc, err := ValueScanner.Raw.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("raw value is not a string: %T", vc)
+	}
+	return predicate.AuditEventOrErr(sql.FieldContainsFold(FieldRaw, vcs), err)
 }
 
 // LevelEQ applies the EQ predicate on the "level" field.
@@ -984,6 +1076,494 @@ func StageContainsFold(v string) predicate.AuditEvent {
 	return predicate.AuditEvent(sql.FieldContainsFold(FieldStage, v))
 }
 
+// ClusterEQ applies the EQ predicate on the "cluster" field.
+func ClusterEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldCluster, v))
+}
+
+// ClusterNEQ applies the NEQ predicate on the "cluster" field.
+func ClusterNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldCluster, v))
+}
+
+// ClusterIn applies the In predicate on the "cluster" field.
+func ClusterIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldCluster, vs...))
+}
+
+// ClusterNotIn applies the NotIn predicate on the "cluster" field.
+func ClusterNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldCluster, vs...))
+}
+
+// ClusterGT applies the GT predicate on the "cluster" field.
+func ClusterGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldCluster, v))
+}
+
+// ClusterGTE applies the GTE predicate on the "cluster" field.
+func ClusterGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldCluster, v))
+}
+
+// ClusterLT applies the LT predicate on the "cluster" field.
+func ClusterLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldCluster, v))
+}
+
+// ClusterLTE applies the LTE predicate on the "cluster" field.
+func ClusterLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldCluster, v))
+}
+
+// ClusterContains applies the Contains predicate on the "cluster" field.
+func ClusterContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldCluster, v))
+}
+
+// ClusterHasPrefix applies the HasPrefix predicate on the "cluster" field.
+func ClusterHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldCluster, v))
+}
+
+// ClusterHasSuffix applies the HasSuffix predicate on the "cluster" field.
+func ClusterHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldCluster, v))
+}
+
+// ClusterEqualFold applies the EqualFold predicate on the "cluster" field.
+func ClusterEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldCluster, v))
+}
+
+// ClusterContainsFold applies the ContainsFold predicate on the "cluster" field.
+func ClusterContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldCluster, v))
+}
+
+// SampleWeightEQ applies the EQ predicate on the "sampleWeight" field.
+func SampleWeightEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldSampleWeight, v))
+}
+
+// SampleWeightNEQ applies the NEQ predicate on the "sampleWeight" field.
+func SampleWeightNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldSampleWeight, v))
+}
+
+// SampleWeightIn applies the In predicate on the "sampleWeight" field.
+func SampleWeightIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldSampleWeight, vs...))
+}
+
+// SampleWeightNotIn applies the NotIn predicate on the "sampleWeight" field.
+func SampleWeightNotIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldSampleWeight, vs...))
+}
+
+// SampleWeightGT applies the GT predicate on the "sampleWeight" field.
+func SampleWeightGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldSampleWeight, v))
+}
+
+// SampleWeightGTE applies the GTE predicate on the "sampleWeight" field.
+func SampleWeightGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldSampleWeight, v))
+}
+
+// SampleWeightLT applies the LT predicate on the "sampleWeight" field.
+func SampleWeightLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldSampleWeight, v))
+}
+
+// SampleWeightLTE applies the LTE predicate on the "sampleWeight" field.
+func SampleWeightLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldSampleWeight, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// UserGroupsIsNil applies the IsNil predicate on the "userGroups" field.
+func UserGroupsIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIsNull(FieldUserGroups))
+}
+
+// UserGroupsNotNil applies the NotNil predicate on the "userGroups" field.
+func UserGroupsNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotNull(FieldUserGroups))
+}
+
+// SourceIPsIsNil applies the IsNil predicate on the "sourceIPs" field.
+func SourceIPsIsNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIsNull(FieldSourceIPs))
+}
+
+// SourceIPsNotNil applies the NotNil predicate on the "sourceIPs" field.
+func SourceIPsNotNil() predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotNull(FieldSourceIPs))
+}
+
+// ResponseCodeEQ applies the EQ predicate on the "responseCode" field.
+func ResponseCodeEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldResponseCode, v))
+}
+
+// ResponseCodeNEQ applies the NEQ predicate on the "responseCode" field.
+func ResponseCodeNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldResponseCode, v))
+}
+
+// ResponseCodeIn applies the In predicate on the "responseCode" field.
+func ResponseCodeIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldResponseCode, vs...))
+}
+
+// ResponseCodeNotIn applies the NotIn predicate on the "responseCode" field.
+func ResponseCodeNotIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldResponseCode, vs...))
+}
+
+// ResponseCodeGT applies the GT predicate on the "responseCode" field.
+func ResponseCodeGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldResponseCode, v))
+}
+
+// ResponseCodeGTE applies the GTE predicate on the "responseCode" field.
+func ResponseCodeGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldResponseCode, v))
+}
+
+// ResponseCodeLT applies the LT predicate on the "responseCode" field.
+func ResponseCodeLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldResponseCode, v))
+}
+
+// ResponseCodeLTE applies the LTE predicate on the "responseCode" field.
+func ResponseCodeLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldResponseCode, v))
+}
+
+// ImpersonatedUserEQ applies the EQ predicate on the "impersonatedUser" field.
+func ImpersonatedUserEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserNEQ applies the NEQ predicate on the "impersonatedUser" field.
+func ImpersonatedUserNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserIn applies the In predicate on the "impersonatedUser" field.
+func ImpersonatedUserIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldImpersonatedUser, vs...))
+}
+
+// ImpersonatedUserNotIn applies the NotIn predicate on the "impersonatedUser" field.
+func ImpersonatedUserNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldImpersonatedUser, vs...))
+}
+
+// ImpersonatedUserGT applies the GT predicate on the "impersonatedUser" field.
+func ImpersonatedUserGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserGTE applies the GTE predicate on the "impersonatedUser" field.
+func ImpersonatedUserGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserLT applies the LT predicate on the "impersonatedUser" field.
+func ImpersonatedUserLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserLTE applies the LTE predicate on the "impersonatedUser" field.
+func ImpersonatedUserLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserContains applies the Contains predicate on the "impersonatedUser" field.
+func ImpersonatedUserContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserHasPrefix applies the HasPrefix predicate on the "impersonatedUser" field.
+func ImpersonatedUserHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserHasSuffix applies the HasSuffix predicate on the "impersonatedUser" field.
+func ImpersonatedUserHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserEqualFold applies the EqualFold predicate on the "impersonatedUser" field.
+func ImpersonatedUserEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldImpersonatedUser, v))
+}
+
+// ImpersonatedUserContainsFold applies the ContainsFold predicate on the "impersonatedUser" field.
+func ImpersonatedUserContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldImpersonatedUser, v))
+}
+
+// UserAgentNameEQ applies the EQ predicate on the "userAgentName" field.
+func UserAgentNameEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldUserAgentName, v))
+}
+
+// UserAgentNameNEQ applies the NEQ predicate on the "userAgentName" field.
+func UserAgentNameNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldUserAgentName, v))
+}
+
+// UserAgentNameIn applies the In predicate on the "userAgentName" field.
+func UserAgentNameIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldUserAgentName, vs...))
+}
+
+// UserAgentNameNotIn applies the NotIn predicate on the "userAgentName" field.
+func UserAgentNameNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldUserAgentName, vs...))
+}
+
+// UserAgentNameGT applies the GT predicate on the "userAgentName" field.
+func UserAgentNameGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldUserAgentName, v))
+}
+
+// UserAgentNameGTE applies the GTE predicate on the "userAgentName" field.
+func UserAgentNameGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldUserAgentName, v))
+}
+
+// UserAgentNameLT applies the LT predicate on the "userAgentName" field.
+func UserAgentNameLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldUserAgentName, v))
+}
+
+// UserAgentNameLTE applies the LTE predicate on the "userAgentName" field.
+func UserAgentNameLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldUserAgentName, v))
+}
+
+// UserAgentNameContains applies the Contains predicate on the "userAgentName" field.
+func UserAgentNameContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldUserAgentName, v))
+}
+
+// UserAgentNameHasPrefix applies the HasPrefix predicate on the "userAgentName" field.
+func UserAgentNameHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldUserAgentName, v))
+}
+
+// UserAgentNameHasSuffix applies the HasSuffix predicate on the "userAgentName" field.
+func UserAgentNameHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldUserAgentName, v))
+}
+
+// UserAgentNameEqualFold applies the EqualFold predicate on the "userAgentName" field.
+func UserAgentNameEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldUserAgentName, v))
+}
+
+// UserAgentNameContainsFold applies the ContainsFold predicate on the "userAgentName" field.
+func UserAgentNameContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldUserAgentName, v))
+}
+
+// RawSizeEQ applies the EQ predicate on the "rawSize" field.
+func RawSizeEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldRawSize, v))
+}
+
+// RawSizeNEQ applies the NEQ predicate on the "rawSize" field.
+func RawSizeNEQ(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldRawSize, v))
+}
+
+// RawSizeIn applies the In predicate on the "rawSize" field.
+func RawSizeIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldRawSize, vs...))
+}
+
+// RawSizeNotIn applies the NotIn predicate on the "rawSize" field.
+func RawSizeNotIn(vs ...int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldRawSize, vs...))
+}
+
+// RawSizeGT applies the GT predicate on the "rawSize" field.
+func RawSizeGT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldRawSize, v))
+}
+
+// RawSizeGTE applies the GTE predicate on the "rawSize" field.
+func RawSizeGTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldRawSize, v))
+}
+
+// RawSizeLT applies the LT predicate on the "rawSize" field.
+func RawSizeLT(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldRawSize, v))
+}
+
+// RawSizeLTE applies the LTE predicate on the "rawSize" field.
+func RawSizeLTE(v int) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldRawSize, v))
+}
+
+// RawKeyIDEQ applies the EQ predicate on the "rawKeyID" field.
+func RawKeyIDEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEQ(FieldRawKeyID, v))
+}
+
+// RawKeyIDNEQ applies the NEQ predicate on the "rawKeyID" field.
+func RawKeyIDNEQ(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNEQ(FieldRawKeyID, v))
+}
+
+// RawKeyIDIn applies the In predicate on the "rawKeyID" field.
+func RawKeyIDIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldIn(FieldRawKeyID, vs...))
+}
+
+// RawKeyIDNotIn applies the NotIn predicate on the "rawKeyID" field.
+func RawKeyIDNotIn(vs ...string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldNotIn(FieldRawKeyID, vs...))
+}
+
+// RawKeyIDGT applies the GT predicate on the "rawKeyID" field.
+func RawKeyIDGT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGT(FieldRawKeyID, v))
+}
+
+// RawKeyIDGTE applies the GTE predicate on the "rawKeyID" field.
+func RawKeyIDGTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldGTE(FieldRawKeyID, v))
+}
+
+// RawKeyIDLT applies the LT predicate on the "rawKeyID" field.
+func RawKeyIDLT(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLT(FieldRawKeyID, v))
+}
+
+// RawKeyIDLTE applies the LTE predicate on the "rawKeyID" field.
+func RawKeyIDLTE(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldLTE(FieldRawKeyID, v))
+}
+
+// RawKeyIDContains applies the Contains predicate on the "rawKeyID" field.
+func RawKeyIDContains(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContains(FieldRawKeyID, v))
+}
+
+// RawKeyIDHasPrefix applies the HasPrefix predicate on the "rawKeyID" field.
+func RawKeyIDHasPrefix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasPrefix(FieldRawKeyID, v))
+}
+
+// RawKeyIDHasSuffix applies the HasSuffix predicate on the "rawKeyID" field.
+func RawKeyIDHasSuffix(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldHasSuffix(FieldRawKeyID, v))
+}
+
+// RawKeyIDEqualFold applies the EqualFold predicate on the "rawKeyID" field.
+func RawKeyIDEqualFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldEqualFold(FieldRawKeyID, v))
+}
+
+// RawKeyIDContainsFold applies the ContainsFold predicate on the "rawKeyID" field.
+func RawKeyIDContainsFold(v string) predicate.AuditEvent {
+	return predicate.AuditEvent(sql.FieldContainsFold(FieldRawKeyID, v))
+}
+
+// HasAnnotations applies the HasEdge predicate on the "annotations" edge.
+func HasAnnotations() predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnnotationsTable, AnnotationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnnotationsWith applies the HasEdge predicate on the "annotations" edge with a given conditions (other predicates).
+func HasAnnotationsWith(preds ...predicate.AuditAnnotation) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		step := newAnnotationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuditEvent) predicate.AuditEvent {
 	return predicate.AuditEvent(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
 )
 
@@ -152,6 +153,145 @@ func (_c *AuditEventCreate) SetStage(v string) *AuditEventCreate {
 	return _c
 }
 
+// SetCluster sets the "cluster" field.
+func (_c *AuditEventCreate) SetCluster(v string) *AuditEventCreate {
+	_c.mutation.SetCluster(v)
+	return _c
+}
+
+// SetNillableCluster sets the "cluster" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableCluster(v *string) *AuditEventCreate {
+	if v != nil {
+		_c.SetCluster(*v)
+	}
+	return _c
+}
+
+// SetSampleWeight sets the "sampleWeight" field.
+func (_c *AuditEventCreate) SetSampleWeight(v int) *AuditEventCreate {
+	_c.mutation.SetSampleWeight(v)
+	return _c
+}
+
+// SetNillableSampleWeight sets the "sampleWeight" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableSampleWeight(v *int) *AuditEventCreate {
+	if v != nil {
+		_c.SetSampleWeight(*v)
+	}
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *AuditEventCreate) SetUsername(v string) *AuditEventCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableUsername(v *string) *AuditEventCreate {
+	if v != nil {
+		_c.SetUsername(*v)
+	}
+	return _c
+}
+
+// SetUserGroups sets the "userGroups" field.
+func (_c *AuditEventCreate) SetUserGroups(v []string) *AuditEventCreate {
+	_c.mutation.SetUserGroups(v)
+	return _c
+}
+
+// SetSourceIPs sets the "sourceIPs" field.
+func (_c *AuditEventCreate) SetSourceIPs(v []string) *AuditEventCreate {
+	_c.mutation.SetSourceIPs(v)
+	return _c
+}
+
+// SetResponseCode sets the "responseCode" field.
+func (_c *AuditEventCreate) SetResponseCode(v int) *AuditEventCreate {
+	_c.mutation.SetResponseCode(v)
+	return _c
+}
+
+// SetNillableResponseCode sets the "responseCode" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableResponseCode(v *int) *AuditEventCreate {
+	if v != nil {
+		_c.SetResponseCode(*v)
+	}
+	return _c
+}
+
+// SetImpersonatedUser sets the "impersonatedUser" field.
+func (_c *AuditEventCreate) SetImpersonatedUser(v string) *AuditEventCreate {
+	_c.mutation.SetImpersonatedUser(v)
+	return _c
+}
+
+// SetNillableImpersonatedUser sets the "impersonatedUser" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableImpersonatedUser(v *string) *AuditEventCreate {
+	if v != nil {
+		_c.SetImpersonatedUser(*v)
+	}
+	return _c
+}
+
+// SetUserAgentName sets the "userAgentName" field.
+func (_c *AuditEventCreate) SetUserAgentName(v string) *AuditEventCreate {
+	_c.mutation.SetUserAgentName(v)
+	return _c
+}
+
+// SetNillableUserAgentName sets the "userAgentName" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableUserAgentName(v *string) *AuditEventCreate {
+	if v != nil {
+		_c.SetUserAgentName(*v)
+	}
+	return _c
+}
+
+// SetRawSize sets the "rawSize" field.
+func (_c *AuditEventCreate) SetRawSize(v int) *AuditEventCreate {
+	_c.mutation.SetRawSize(v)
+	return _c
+}
+
+// SetNillableRawSize sets the "rawSize" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableRawSize(v *int) *AuditEventCreate {
+	if v != nil {
+		_c.SetRawSize(*v)
+	}
+	return _c
+}
+
+// SetRawKeyID sets the "rawKeyID" field.
+func (_c *AuditEventCreate) SetRawKeyID(v string) *AuditEventCreate {
+	_c.mutation.SetRawKeyID(v)
+	return _c
+}
+
+// SetNillableRawKeyID sets the "rawKeyID" field if the given value is not nil.
+func (_c *AuditEventCreate) SetNillableRawKeyID(v *string) *AuditEventCreate {
+	if v != nil {
+		_c.SetRawKeyID(*v)
+	}
+	return _c
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the AuditAnnotation entity by IDs.
+func (_c *AuditEventCreate) AddAnnotationIDs(ids ...int) *AuditEventCreate {
+	_c.mutation.AddAnnotationIDs(ids...)
+	return _c
+}
+
+// AddAnnotations adds the "annotations" edges to the AuditAnnotation entity.
+func (_c *AuditEventCreate) AddAnnotations(v ...*AuditAnnotation) *AuditEventCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnnotationIDs(ids...)
+}
+
 // Mutation returns the AuditEventMutation object of the builder.
 func (_c *AuditEventCreate) Mutation() *AuditEventMutation {
 	return _c.mutation
@@ -211,6 +351,38 @@ func (_c *AuditEventCreate) defaults() {
 		v := auditevent.DefaultSubResource
 		_c.mutation.SetSubResource(v)
 	}
+	if _, ok := _c.mutation.Cluster(); !ok {
+		v := auditevent.DefaultCluster
+		_c.mutation.SetCluster(v)
+	}
+	if _, ok := _c.mutation.SampleWeight(); !ok {
+		v := auditevent.DefaultSampleWeight
+		_c.mutation.SetSampleWeight(v)
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		v := auditevent.DefaultUsername
+		_c.mutation.SetUsername(v)
+	}
+	if _, ok := _c.mutation.ResponseCode(); !ok {
+		v := auditevent.DefaultResponseCode
+		_c.mutation.SetResponseCode(v)
+	}
+	if _, ok := _c.mutation.ImpersonatedUser(); !ok {
+		v := auditevent.DefaultImpersonatedUser
+		_c.mutation.SetImpersonatedUser(v)
+	}
+	if _, ok := _c.mutation.UserAgentName(); !ok {
+		v := auditevent.DefaultUserAgentName
+		_c.mutation.SetUserAgentName(v)
+	}
+	if _, ok := _c.mutation.RawSize(); !ok {
+		v := auditevent.DefaultRawSize
+		_c.mutation.SetRawSize(v)
+	}
+	if _, ok := _c.mutation.RawKeyID(); !ok {
+		v := auditevent.DefaultRawKeyID
+		_c.mutation.SetRawKeyID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -282,6 +454,30 @@ func (_c *AuditEventCreate) check() error {
 	if _, ok := _c.mutation.Stage(); !ok {
 		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "AuditEvent.stage"`)}
 	}
+	if _, ok := _c.mutation.Cluster(); !ok {
+		return &ValidationError{Name: "cluster", err: errors.New(`ent: missing required field "AuditEvent.cluster"`)}
+	}
+	if _, ok := _c.mutation.SampleWeight(); !ok {
+		return &ValidationError{Name: "sampleWeight", err: errors.New(`ent: missing required field "AuditEvent.sampleWeight"`)}
+	}
+	if _, ok := _c.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "AuditEvent.username"`)}
+	}
+	if _, ok := _c.mutation.ResponseCode(); !ok {
+		return &ValidationError{Name: "responseCode", err: errors.New(`ent: missing required field "AuditEvent.responseCode"`)}
+	}
+	if _, ok := _c.mutation.ImpersonatedUser(); !ok {
+		return &ValidationError{Name: "impersonatedUser", err: errors.New(`ent: missing required field "AuditEvent.impersonatedUser"`)}
+	}
+	if _, ok := _c.mutation.UserAgentName(); !ok {
+		return &ValidationError{Name: "userAgentName", err: errors.New(`ent: missing required field "AuditEvent.userAgentName"`)}
+	}
+	if _, ok := _c.mutation.RawSize(); !ok {
+		return &ValidationError{Name: "rawSize", err: errors.New(`ent: missing required field "AuditEvent.rawSize"`)}
+	}
+	if _, ok := _c.mutation.RawKeyID(); !ok {
+		return &ValidationError{Name: "rawKeyID", err: errors.New(`ent: missing required field "AuditEvent.rawKeyID"`)}
+	}
 	return nil
 }
 
@@ -289,7 +485,10 @@ func (_c *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
 	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := _c.createSpec()
+	_node, _spec, err := _c.createSpec()
+	if err != nil {
+		return nil, err
+	}
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
@@ -303,13 +502,17 @@ func (_c *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
 	return _node, nil
 }
 
-func (_c *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+func (_c *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec, error) {
 	var (
 		_node = &AuditEvent{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
 	)
 	if value, ok := _c.mutation.Raw(); ok {
-		_spec.SetField(auditevent.FieldRaw, field.TypeString, value)
+		vv, err := auditevent.ValueScanner.Raw.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(auditevent.FieldRaw, field.TypeString, vv)
 		_node.Raw = value
 	}
 	if value, ok := _c.mutation.Level(); ok {
@@ -364,7 +567,63 @@ func (_c *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
 		_spec.SetField(auditevent.FieldStage, field.TypeString, value)
 		_node.Stage = value
 	}
-	return _node, _spec
+	if value, ok := _c.mutation.Cluster(); ok {
+		_spec.SetField(auditevent.FieldCluster, field.TypeString, value)
+		_node.Cluster = value
+	}
+	if value, ok := _c.mutation.SampleWeight(); ok {
+		_spec.SetField(auditevent.FieldSampleWeight, field.TypeInt, value)
+		_node.SampleWeight = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(auditevent.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.UserGroups(); ok {
+		_spec.SetField(auditevent.FieldUserGroups, field.TypeJSON, value)
+		_node.UserGroups = value
+	}
+	if value, ok := _c.mutation.SourceIPs(); ok {
+		_spec.SetField(auditevent.FieldSourceIPs, field.TypeJSON, value)
+		_node.SourceIPs = value
+	}
+	if value, ok := _c.mutation.ResponseCode(); ok {
+		_spec.SetField(auditevent.FieldResponseCode, field.TypeInt, value)
+		_node.ResponseCode = value
+	}
+	if value, ok := _c.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(auditevent.FieldImpersonatedUser, field.TypeString, value)
+		_node.ImpersonatedUser = value
+	}
+	if value, ok := _c.mutation.UserAgentName(); ok {
+		_spec.SetField(auditevent.FieldUserAgentName, field.TypeString, value)
+		_node.UserAgentName = value
+	}
+	if value, ok := _c.mutation.RawSize(); ok {
+		_spec.SetField(auditevent.FieldRawSize, field.TypeInt, value)
+		_node.RawSize = value
+	}
+	if value, ok := _c.mutation.RawKeyID(); ok {
+		_spec.SetField(auditevent.FieldRawKeyID, field.TypeString, value)
+		_node.RawKeyID = value
+	}
+	if nodes := _c.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec, nil
 }
 
 // AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
@@ -396,7 +655,10 @@ func (_c *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error)
 				}
 				builder.mutation = mutation
 				var err error
-				nodes[i], specs[i] = builder.createSpec()
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
 )
@@ -18,12 +20,14 @@ import (
 // AuditEventQuery is the builder for querying AuditEvent entities.
 type AuditEventQuery struct {
 	config
-	ctx        *QueryContext
-	order      []auditevent.OrderOption
-	inters     []Interceptor
-	predicates []predicate.AuditEvent
-	modifiers  []func(*sql.Selector)
-	loadTotal  []func(context.Context, []*AuditEvent) error
+	ctx                  *QueryContext
+	order                []auditevent.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.AuditEvent
+	withAnnotations      *AuditAnnotationQuery
+	modifiers            []func(*sql.Selector)
+	loadTotal            []func(context.Context, []*AuditEvent) error
+	withNamedAnnotations map[string]*AuditAnnotationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +64,28 @@ func (_q *AuditEventQuery) Order(o ...auditevent.OrderOption) *AuditEventQuery {
 	return _q
 }
 
+// QueryAnnotations chains the current query on the "annotations" edge.
+func (_q *AuditEventQuery) QueryAnnotations() *AuditAnnotationQuery {
+	query := (&AuditAnnotationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditevent.Table, auditevent.FieldID, selector),
+			sqlgraph.To(auditannotation.Table, auditannotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, auditevent.AnnotationsTable, auditevent.AnnotationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AuditEvent entity from the query.
 // Returns a *NotFoundError when no AuditEvent was found.
 func (_q *AuditEventQuery) First(ctx context.Context) (*AuditEvent, error) {
@@ -247,17 +273,29 @@ func (_q *AuditEventQuery) Clone() *AuditEventQuery {
 		return nil
 	}
 	return &AuditEventQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]auditevent.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.AuditEvent{}, _q.predicates...),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]auditevent.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.AuditEvent{}, _q.predicates...),
+		withAnnotations: _q.withAnnotations.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithAnnotations tells the query-builder to eager-load the nodes that are connected to
+// the "annotations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AuditEventQuery) WithAnnotations(opts ...func(*AuditAnnotationQuery)) *AuditEventQuery {
+	query := (&AuditAnnotationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnnotations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,8 +372,11 @@ func (_q *AuditEventQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *AuditEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AuditEvent, error) {
 	var (
-		nodes = []*AuditEvent{}
-		_spec = _q.querySpec()
+		nodes       = []*AuditEvent{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withAnnotations != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*AuditEvent).scanValues(nil, columns)
@@ -343,6 +384,7 @@ func (_q *AuditEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &AuditEvent{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(_q.modifiers) > 0 {
@@ -357,6 +399,20 @@ func (_q *AuditEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withAnnotations; query != nil {
+		if err := _q.loadAnnotations(ctx, query, nodes,
+			func(n *AuditEvent) { n.Edges.Annotations = []*AuditAnnotation{} },
+			func(n *AuditEvent, e *AuditAnnotation) { n.Edges.Annotations = append(n.Edges.Annotations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedAnnotations {
+		if err := _q.loadAnnotations(ctx, query, nodes,
+			func(n *AuditEvent) { n.appendNamedAnnotations(name) },
+			func(n *AuditEvent, e *AuditAnnotation) { n.appendNamedAnnotations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range _q.loadTotal {
 		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -365,6 +421,38 @@ func (_q *AuditEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 	return nodes, nil
 }
 
+func (_q *AuditEventQuery) loadAnnotations(ctx context.Context, query *AuditAnnotationQuery, nodes []*AuditEvent, init func(*AuditEvent), assign func(*AuditEvent, *AuditAnnotation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AuditEvent)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuditAnnotation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(auditevent.AnnotationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.audit_event_annotations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "audit_event_annotations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "audit_event_annotations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *AuditEventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	if len(_q.modifiers) > 0 {
@@ -449,6 +537,20 @@ func (_q *AuditEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedAnnotations tells the query-builder to eager-load the nodes that are connected to the "annotations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *AuditEventQuery) WithNamedAnnotations(name string, opts ...func(*AuditAnnotationQuery)) *AuditEventQuery {
+	query := (&AuditAnnotationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedAnnotations == nil {
+		_q.withNamedAnnotations = make(map[string]*AuditAnnotationQuery)
+	}
+	_q.withNamedAnnotations[name] = query
+	return _q
+}
+
 // AuditEventGroupBy is the group-by builder for AuditEvent entities.
 type AuditEventGroupBy struct {
 	selector
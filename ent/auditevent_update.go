@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
 )
@@ -27,11 +29,195 @@ func (_u *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate
 	return _u
 }
 
+// SetRaw sets the "raw" field.
+func (_u *AuditEventUpdate) SetRaw(v string) *AuditEventUpdate {
+	_u.mutation.SetRaw(v)
+	return _u
+}
+
+// SetNillableRaw sets the "raw" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableRaw(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetRaw(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *AuditEventUpdate) SetUsername(v string) *AuditEventUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableUsername(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetUserGroups sets the "userGroups" field.
+func (_u *AuditEventUpdate) SetUserGroups(v []string) *AuditEventUpdate {
+	_u.mutation.SetUserGroups(v)
+	return _u
+}
+
+// AppendUserGroups appends value to the "userGroups" field.
+func (_u *AuditEventUpdate) AppendUserGroups(v []string) *AuditEventUpdate {
+	_u.mutation.AppendUserGroups(v)
+	return _u
+}
+
+// ClearUserGroups clears the value of the "userGroups" field.
+func (_u *AuditEventUpdate) ClearUserGroups() *AuditEventUpdate {
+	_u.mutation.ClearUserGroups()
+	return _u
+}
+
+// SetSourceIPs sets the "sourceIPs" field.
+func (_u *AuditEventUpdate) SetSourceIPs(v []string) *AuditEventUpdate {
+	_u.mutation.SetSourceIPs(v)
+	return _u
+}
+
+// AppendSourceIPs appends value to the "sourceIPs" field.
+func (_u *AuditEventUpdate) AppendSourceIPs(v []string) *AuditEventUpdate {
+	_u.mutation.AppendSourceIPs(v)
+	return _u
+}
+
+// ClearSourceIPs clears the value of the "sourceIPs" field.
+func (_u *AuditEventUpdate) ClearSourceIPs() *AuditEventUpdate {
+	_u.mutation.ClearSourceIPs()
+	return _u
+}
+
+// SetResponseCode sets the "responseCode" field.
+func (_u *AuditEventUpdate) SetResponseCode(v int) *AuditEventUpdate {
+	_u.mutation.ResetResponseCode()
+	_u.mutation.SetResponseCode(v)
+	return _u
+}
+
+// SetNillableResponseCode sets the "responseCode" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableResponseCode(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetResponseCode(*v)
+	}
+	return _u
+}
+
+// AddResponseCode adds value to the "responseCode" field.
+func (_u *AuditEventUpdate) AddResponseCode(v int) *AuditEventUpdate {
+	_u.mutation.AddResponseCode(v)
+	return _u
+}
+
+// SetImpersonatedUser sets the "impersonatedUser" field.
+func (_u *AuditEventUpdate) SetImpersonatedUser(v string) *AuditEventUpdate {
+	_u.mutation.SetImpersonatedUser(v)
+	return _u
+}
+
+// SetNillableImpersonatedUser sets the "impersonatedUser" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableImpersonatedUser(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetImpersonatedUser(*v)
+	}
+	return _u
+}
+
+// SetUserAgentName sets the "userAgentName" field.
+func (_u *AuditEventUpdate) SetUserAgentName(v string) *AuditEventUpdate {
+	_u.mutation.SetUserAgentName(v)
+	return _u
+}
+
+// SetNillableUserAgentName sets the "userAgentName" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableUserAgentName(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetUserAgentName(*v)
+	}
+	return _u
+}
+
+// SetRawSize sets the "rawSize" field.
+func (_u *AuditEventUpdate) SetRawSize(v int) *AuditEventUpdate {
+	_u.mutation.ResetRawSize()
+	_u.mutation.SetRawSize(v)
+	return _u
+}
+
+// SetNillableRawSize sets the "rawSize" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableRawSize(v *int) *AuditEventUpdate {
+	if v != nil {
+		_u.SetRawSize(*v)
+	}
+	return _u
+}
+
+// AddRawSize adds value to the "rawSize" field.
+func (_u *AuditEventUpdate) AddRawSize(v int) *AuditEventUpdate {
+	_u.mutation.AddRawSize(v)
+	return _u
+}
+
+// SetRawKeyID sets the "rawKeyID" field.
+func (_u *AuditEventUpdate) SetRawKeyID(v string) *AuditEventUpdate {
+	_u.mutation.SetRawKeyID(v)
+	return _u
+}
+
+// SetNillableRawKeyID sets the "rawKeyID" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableRawKeyID(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetRawKeyID(*v)
+	}
+	return _u
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the AuditAnnotation entity by IDs.
+func (_u *AuditEventUpdate) AddAnnotationIDs(ids ...int) *AuditEventUpdate {
+	_u.mutation.AddAnnotationIDs(ids...)
+	return _u
+}
+
+// AddAnnotations adds the "annotations" edges to the AuditAnnotation entity.
+func (_u *AuditEventUpdate) AddAnnotations(v ...*AuditAnnotation) *AuditEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnnotationIDs(ids...)
+}
+
 // Mutation returns the AuditEventMutation object of the builder.
 func (_u *AuditEventUpdate) Mutation() *AuditEventMutation {
 	return _u.mutation
 }
 
+// ClearAnnotations clears all "annotations" edges to the AuditAnnotation entity.
+func (_u *AuditEventUpdate) ClearAnnotations() *AuditEventUpdate {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to AuditAnnotation entities by IDs.
+func (_u *AuditEventUpdate) RemoveAnnotationIDs(ids ...int) *AuditEventUpdate {
+	_u.mutation.RemoveAnnotationIDs(ids...)
+	return _u
+}
+
+// RemoveAnnotations removes "annotations" edges to AuditAnnotation entities.
+func (_u *AuditEventUpdate) RemoveAnnotations(v ...*AuditAnnotation) *AuditEventUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnnotationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AuditEventUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -59,7 +245,20 @@ func (_u *AuditEventUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdate) check() error {
+	if v, ok := _u.mutation.Raw(); ok {
+		if err := auditevent.RawValidator(v); err != nil {
+			return &ValidationError{Name: "raw", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.raw": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *AuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -68,6 +267,104 @@ func (_u *AuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.Raw(); ok {
+		vv, err := auditevent.ValueScanner.Raw.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(auditevent.FieldRaw, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(auditevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserGroups(); ok {
+		_spec.SetField(auditevent.FieldUserGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUserGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldUserGroups, value)
+		})
+	}
+	if _u.mutation.UserGroupsCleared() {
+		_spec.ClearField(auditevent.FieldUserGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SourceIPs(); ok {
+		_spec.SetField(auditevent.FieldSourceIPs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceIPs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldSourceIPs, value)
+		})
+	}
+	if _u.mutation.SourceIPsCleared() {
+		_spec.ClearField(auditevent.FieldSourceIPs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResponseCode(); ok {
+		_spec.SetField(auditevent.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCode(); ok {
+		_spec.AddField(auditevent.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(auditevent.FieldImpersonatedUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgentName(); ok {
+		_spec.SetField(auditevent.FieldUserAgentName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RawSize(); ok {
+		_spec.SetField(auditevent.FieldRawSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRawSize(); ok {
+		_spec.AddField(auditevent.FieldRawSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RawKeyID(); ok {
+		_spec.SetField(auditevent.FieldRawKeyID, field.TypeString, value)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnnotationsIDs(); len(nodes) > 0 && !_u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{auditevent.Label}
@@ -88,11 +385,195 @@ type AuditEventUpdateOne struct {
 	mutation *AuditEventMutation
 }
 
+// SetRaw sets the "raw" field.
+func (_u *AuditEventUpdateOne) SetRaw(v string) *AuditEventUpdateOne {
+	_u.mutation.SetRaw(v)
+	return _u
+}
+
+// SetNillableRaw sets the "raw" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableRaw(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetRaw(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *AuditEventUpdateOne) SetUsername(v string) *AuditEventUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableUsername(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// SetUserGroups sets the "userGroups" field.
+func (_u *AuditEventUpdateOne) SetUserGroups(v []string) *AuditEventUpdateOne {
+	_u.mutation.SetUserGroups(v)
+	return _u
+}
+
+// AppendUserGroups appends value to the "userGroups" field.
+func (_u *AuditEventUpdateOne) AppendUserGroups(v []string) *AuditEventUpdateOne {
+	_u.mutation.AppendUserGroups(v)
+	return _u
+}
+
+// ClearUserGroups clears the value of the "userGroups" field.
+func (_u *AuditEventUpdateOne) ClearUserGroups() *AuditEventUpdateOne {
+	_u.mutation.ClearUserGroups()
+	return _u
+}
+
+// SetSourceIPs sets the "sourceIPs" field.
+func (_u *AuditEventUpdateOne) SetSourceIPs(v []string) *AuditEventUpdateOne {
+	_u.mutation.SetSourceIPs(v)
+	return _u
+}
+
+// AppendSourceIPs appends value to the "sourceIPs" field.
+func (_u *AuditEventUpdateOne) AppendSourceIPs(v []string) *AuditEventUpdateOne {
+	_u.mutation.AppendSourceIPs(v)
+	return _u
+}
+
+// ClearSourceIPs clears the value of the "sourceIPs" field.
+func (_u *AuditEventUpdateOne) ClearSourceIPs() *AuditEventUpdateOne {
+	_u.mutation.ClearSourceIPs()
+	return _u
+}
+
+// SetResponseCode sets the "responseCode" field.
+func (_u *AuditEventUpdateOne) SetResponseCode(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetResponseCode()
+	_u.mutation.SetResponseCode(v)
+	return _u
+}
+
+// SetNillableResponseCode sets the "responseCode" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableResponseCode(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetResponseCode(*v)
+	}
+	return _u
+}
+
+// AddResponseCode adds value to the "responseCode" field.
+func (_u *AuditEventUpdateOne) AddResponseCode(v int) *AuditEventUpdateOne {
+	_u.mutation.AddResponseCode(v)
+	return _u
+}
+
+// SetImpersonatedUser sets the "impersonatedUser" field.
+func (_u *AuditEventUpdateOne) SetImpersonatedUser(v string) *AuditEventUpdateOne {
+	_u.mutation.SetImpersonatedUser(v)
+	return _u
+}
+
+// SetNillableImpersonatedUser sets the "impersonatedUser" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableImpersonatedUser(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetImpersonatedUser(*v)
+	}
+	return _u
+}
+
+// SetUserAgentName sets the "userAgentName" field.
+func (_u *AuditEventUpdateOne) SetUserAgentName(v string) *AuditEventUpdateOne {
+	_u.mutation.SetUserAgentName(v)
+	return _u
+}
+
+// SetNillableUserAgentName sets the "userAgentName" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableUserAgentName(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetUserAgentName(*v)
+	}
+	return _u
+}
+
+// SetRawSize sets the "rawSize" field.
+func (_u *AuditEventUpdateOne) SetRawSize(v int) *AuditEventUpdateOne {
+	_u.mutation.ResetRawSize()
+	_u.mutation.SetRawSize(v)
+	return _u
+}
+
+// SetNillableRawSize sets the "rawSize" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableRawSize(v *int) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetRawSize(*v)
+	}
+	return _u
+}
+
+// AddRawSize adds value to the "rawSize" field.
+func (_u *AuditEventUpdateOne) AddRawSize(v int) *AuditEventUpdateOne {
+	_u.mutation.AddRawSize(v)
+	return _u
+}
+
+// SetRawKeyID sets the "rawKeyID" field.
+func (_u *AuditEventUpdateOne) SetRawKeyID(v string) *AuditEventUpdateOne {
+	_u.mutation.SetRawKeyID(v)
+	return _u
+}
+
+// SetNillableRawKeyID sets the "rawKeyID" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableRawKeyID(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetRawKeyID(*v)
+	}
+	return _u
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the AuditAnnotation entity by IDs.
+func (_u *AuditEventUpdateOne) AddAnnotationIDs(ids ...int) *AuditEventUpdateOne {
+	_u.mutation.AddAnnotationIDs(ids...)
+	return _u
+}
+
+// AddAnnotations adds the "annotations" edges to the AuditAnnotation entity.
+func (_u *AuditEventUpdateOne) AddAnnotations(v ...*AuditAnnotation) *AuditEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnnotationIDs(ids...)
+}
+
 // Mutation returns the AuditEventMutation object of the builder.
 func (_u *AuditEventUpdateOne) Mutation() *AuditEventMutation {
 	return _u.mutation
 }
 
+// ClearAnnotations clears all "annotations" edges to the AuditAnnotation entity.
+func (_u *AuditEventUpdateOne) ClearAnnotations() *AuditEventUpdateOne {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to AuditAnnotation entities by IDs.
+func (_u *AuditEventUpdateOne) RemoveAnnotationIDs(ids ...int) *AuditEventUpdateOne {
+	_u.mutation.RemoveAnnotationIDs(ids...)
+	return _u
+}
+
+// RemoveAnnotations removes "annotations" edges to AuditAnnotation entities.
+func (_u *AuditEventUpdateOne) RemoveAnnotations(v ...*AuditAnnotation) *AuditEventUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnnotationIDs(ids...)
+}
+
 // Where appends a list predicates to the AuditEventUpdate builder.
 func (_u *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
 	_u.mutation.Where(ps...)
@@ -133,7 +614,20 @@ func (_u *AuditEventUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Raw(); ok {
+		if err := auditevent.RawValidator(v); err != nil {
+			return &ValidationError{Name: "raw", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.raw": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -159,6 +653,104 @@ func (_u *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent,
 			}
 		}
 	}
+	if value, ok := _u.mutation.Raw(); ok {
+		vv, err := auditevent.ValueScanner.Raw.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(auditevent.FieldRaw, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(auditevent.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserGroups(); ok {
+		_spec.SetField(auditevent.FieldUserGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUserGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldUserGroups, value)
+		})
+	}
+	if _u.mutation.UserGroupsCleared() {
+		_spec.ClearField(auditevent.FieldUserGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SourceIPs(); ok {
+		_spec.SetField(auditevent.FieldSourceIPs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSourceIPs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldSourceIPs, value)
+		})
+	}
+	if _u.mutation.SourceIPsCleared() {
+		_spec.ClearField(auditevent.FieldSourceIPs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResponseCode(); ok {
+		_spec.SetField(auditevent.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCode(); ok {
+		_spec.AddField(auditevent.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImpersonatedUser(); ok {
+		_spec.SetField(auditevent.FieldImpersonatedUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgentName(); ok {
+		_spec.SetField(auditevent.FieldUserAgentName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RawSize(); ok {
+		_spec.SetField(auditevent.FieldRawSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRawSize(); ok {
+		_spec.AddField(auditevent.FieldRawSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RawKeyID(); ok {
+		_spec.SetField(auditevent.FieldRawKeyID, field.TypeString, value)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnnotationsIDs(); len(nodes) > 0 && !_u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   auditevent.AnnotationsTable,
+			Columns: []string{auditevent.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditannotation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AuditEvent{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
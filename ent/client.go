@@ -14,7 +14,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/view"
 )
@@ -24,8 +27,12 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditAnnotation is the client for interacting with the AuditAnnotation builders.
+	AuditAnnotation *AuditAnnotationClient
 	// AuditEvent is the client for interacting with the AuditEvent builders.
 	AuditEvent *AuditEventClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// ResourceKind is the client for interacting with the ResourceKind builders.
 	ResourceKind *ResourceKindClient
 	// View is the client for interacting with the View builders.
@@ -43,7 +50,9 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditAnnotation = NewAuditAnnotationClient(c.config)
 	c.AuditEvent = NewAuditEventClient(c.config)
+	c.DeadLetter = NewDeadLetterClient(c.config)
 	c.ResourceKind = NewResourceKindClient(c.config)
 	c.View = NewViewClient(c.config)
 }
@@ -136,11 +145,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		AuditEvent:   NewAuditEventClient(cfg),
-		ResourceKind: NewResourceKindClient(cfg),
-		View:         NewViewClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditAnnotation: NewAuditAnnotationClient(cfg),
+		AuditEvent:      NewAuditEventClient(cfg),
+		DeadLetter:      NewDeadLetterClient(cfg),
+		ResourceKind:    NewResourceKindClient(cfg),
+		View:            NewViewClient(cfg),
 	}, nil
 }
 
@@ -158,18 +169,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		AuditEvent:   NewAuditEventClient(cfg),
-		ResourceKind: NewResourceKindClient(cfg),
-		View:         NewViewClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditAnnotation: NewAuditAnnotationClient(cfg),
+		AuditEvent:      NewAuditEventClient(cfg),
+		DeadLetter:      NewDeadLetterClient(cfg),
+		ResourceKind:    NewResourceKindClient(cfg),
+		View:            NewViewClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditEvent.
+//		AuditAnnotation.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -191,7 +204,9 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AuditAnnotation.Use(hooks...)
 	c.AuditEvent.Use(hooks...)
+	c.DeadLetter.Use(hooks...)
 	c.ResourceKind.Use(hooks...)
 	c.View.Use(hooks...)
 }
@@ -199,7 +214,9 @@ func (c *Client) Use(hooks ...Hook) {
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.AuditAnnotation.Intercept(interceptors...)
 	c.AuditEvent.Intercept(interceptors...)
+	c.DeadLetter.Intercept(interceptors...)
 	c.ResourceKind.Intercept(interceptors...)
 	c.View.Intercept(interceptors...)
 }
@@ -207,8 +224,12 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditAnnotationMutation:
+		return c.AuditAnnotation.mutate(ctx, m)
 	case *AuditEventMutation:
 		return c.AuditEvent.mutate(ctx, m)
+	case *DeadLetterMutation:
+		return c.DeadLetter.mutate(ctx, m)
 	case *ResourceKindMutation:
 		return c.ResourceKind.mutate(ctx, m)
 	case *ViewMutation:
@@ -218,6 +239,155 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AuditAnnotationClient is a client for the AuditAnnotation schema.
+type AuditAnnotationClient struct {
+	config
+}
+
+// NewAuditAnnotationClient returns a client for the AuditAnnotation from the given config.
+func NewAuditAnnotationClient(c config) *AuditAnnotationClient {
+	return &AuditAnnotationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditannotation.Hooks(f(g(h())))`.
+func (c *AuditAnnotationClient) Use(hooks ...Hook) {
+	c.hooks.AuditAnnotation = append(c.hooks.AuditAnnotation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditannotation.Intercept(f(g(h())))`.
+func (c *AuditAnnotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditAnnotation = append(c.inters.AuditAnnotation, interceptors...)
+}
+
+// Create returns a builder for creating a AuditAnnotation entity.
+func (c *AuditAnnotationClient) Create() *AuditAnnotationCreate {
+	mutation := newAuditAnnotationMutation(c.config, OpCreate)
+	return &AuditAnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditAnnotation entities.
+func (c *AuditAnnotationClient) CreateBulk(builders ...*AuditAnnotationCreate) *AuditAnnotationCreateBulk {
+	return &AuditAnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditAnnotationClient) MapCreateBulk(slice any, setFunc func(*AuditAnnotationCreate, int)) *AuditAnnotationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditAnnotationCreateBulk{err: fmt.Errorf("calling to AuditAnnotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditAnnotationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditAnnotationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditAnnotation.
+func (c *AuditAnnotationClient) Update() *AuditAnnotationUpdate {
+	mutation := newAuditAnnotationMutation(c.config, OpUpdate)
+	return &AuditAnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditAnnotationClient) UpdateOne(_m *AuditAnnotation) *AuditAnnotationUpdateOne {
+	mutation := newAuditAnnotationMutation(c.config, OpUpdateOne, withAuditAnnotation(_m))
+	return &AuditAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditAnnotationClient) UpdateOneID(id int) *AuditAnnotationUpdateOne {
+	mutation := newAuditAnnotationMutation(c.config, OpUpdateOne, withAuditAnnotationID(id))
+	return &AuditAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditAnnotation.
+func (c *AuditAnnotationClient) Delete() *AuditAnnotationDelete {
+	mutation := newAuditAnnotationMutation(c.config, OpDelete)
+	return &AuditAnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditAnnotationClient) DeleteOne(_m *AuditAnnotation) *AuditAnnotationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditAnnotationClient) DeleteOneID(id int) *AuditAnnotationDeleteOne {
+	builder := c.Delete().Where(auditannotation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditAnnotationDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditAnnotation.
+func (c *AuditAnnotationClient) Query() *AuditAnnotationQuery {
+	return &AuditAnnotationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditAnnotation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditAnnotation entity by its id.
+func (c *AuditAnnotationClient) Get(ctx context.Context, id int) (*AuditAnnotation, error) {
+	return c.Query().Where(auditannotation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditAnnotationClient) GetX(ctx context.Context, id int) *AuditAnnotation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEvent queries the event edge of a AuditAnnotation.
+func (c *AuditAnnotationClient) QueryEvent(_m *AuditAnnotation) *AuditEventQuery {
+	query := (&AuditEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditannotation.Table, auditannotation.FieldID, id),
+			sqlgraph.To(auditevent.Table, auditevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditannotation.EventTable, auditannotation.EventColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuditAnnotationClient) Hooks() []Hook {
+	return c.hooks.AuditAnnotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditAnnotationClient) Interceptors() []Interceptor {
+	return c.inters.AuditAnnotation
+}
+
+func (c *AuditAnnotationClient) mutate(ctx context.Context, m *AuditAnnotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditAnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditAnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditAnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditAnnotation mutation op: %q", m.Op())
+	}
+}
+
 // AuditEventClient is a client for the AuditEvent schema.
 type AuditEventClient struct {
 	config
@@ -326,6 +496,22 @@ func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
 	return obj
 }
 
+// QueryAnnotations queries the annotations edge of a AuditEvent.
+func (c *AuditEventClient) QueryAnnotations(_m *AuditEvent) *AuditAnnotationQuery {
+	query := (&AuditAnnotationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditevent.Table, auditevent.FieldID, id),
+			sqlgraph.To(auditannotation.Table, auditannotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, auditevent.AnnotationsTable, auditevent.AnnotationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AuditEventClient) Hooks() []Hook {
 	return c.hooks.AuditEvent
@@ -351,6 +537,139 @@ func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (V
 	}
 }
 
+// DeadLetterClient is a client for the DeadLetter schema.
+type DeadLetterClient struct {
+	config
+}
+
+// NewDeadLetterClient returns a client for the DeadLetter from the given config.
+func NewDeadLetterClient(c config) *DeadLetterClient {
+	return &DeadLetterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deadletter.Hooks(f(g(h())))`.
+func (c *DeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetter = append(c.hooks.DeadLetter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deadletter.Intercept(f(g(h())))`.
+func (c *DeadLetterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetter = append(c.inters.DeadLetter, interceptors...)
+}
+
+// Create returns a builder for creating a DeadLetter entity.
+func (c *DeadLetterClient) Create() *DeadLetterCreate {
+	mutation := newDeadLetterMutation(c.config, OpCreate)
+	return &DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeadLetter entities.
+func (c *DeadLetterClient) CreateBulk(builders ...*DeadLetterCreate) *DeadLetterCreateBulk {
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeadLetterClient) MapCreateBulk(slice any, setFunc func(*DeadLetterCreate, int)) *DeadLetterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeadLetterCreateBulk{err: fmt.Errorf("calling to DeadLetterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeadLetterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeadLetter.
+func (c *DeadLetterClient) Update() *DeadLetterUpdate {
+	mutation := newDeadLetterMutation(c.config, OpUpdate)
+	return &DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeadLetterClient) UpdateOne(_m *DeadLetter) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetter(_m))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeadLetterClient) UpdateOneID(id int) *DeadLetterUpdateOne {
+	mutation := newDeadLetterMutation(c.config, OpUpdateOne, withDeadLetterID(id))
+	return &DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeadLetter.
+func (c *DeadLetterClient) Delete() *DeadLetterDelete {
+	mutation := newDeadLetterMutation(c.config, OpDelete)
+	return &DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeadLetterClient) DeleteOne(_m *DeadLetter) *DeadLetterDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeadLetterClient) DeleteOneID(id int) *DeadLetterDeleteOne {
+	builder := c.Delete().Where(deadletter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeadLetterDeleteOne{builder}
+}
+
+// Query returns a query builder for DeadLetter.
+func (c *DeadLetterClient) Query() *DeadLetterQuery {
+	return &DeadLetterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeadLetter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeadLetter entity by its id.
+func (c *DeadLetterClient) Get(ctx context.Context, id int) (*DeadLetter, error) {
+	return c.Query().Where(deadletter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeadLetterClient) GetX(ctx context.Context, id int) *DeadLetter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeadLetterClient) Hooks() []Hook {
+	return c.hooks.DeadLetter
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeadLetterClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetter
+}
+
+func (c *DeadLetterClient) mutate(ctx context.Context, m *DeadLetterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeadLetter mutation op: %q", m.Op())
+	}
+}
+
 // ResourceKindClient is a client for the ResourceKind schema.
 type ResourceKindClient struct {
 	config
@@ -620,9 +939,9 @@ func (c *ViewClient) mutate(ctx context.Context, m *ViewMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditEvent, ResourceKind, View []ent.Hook
+		AuditAnnotation, AuditEvent, DeadLetter, ResourceKind, View []ent.Hook
 	}
 	inters struct {
-		AuditEvent, ResourceKind, View []ent.Interceptor
+		AuditAnnotation, AuditEvent, DeadLetter, ResourceKind, View []ent.Interceptor
 	}
 )
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
+)
+
+// DeadLetter is the model entity for the DeadLetter schema.
+type DeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Cluster holds the value of the "cluster" field.
+	Cluster string `json:"cluster,omitempty"`
+	// RemoteAddr holds the value of the "remoteAddr" field.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// PayloadKeyID holds the value of the "payloadKeyID" field.
+	PayloadKeyID string `json:"payloadKeyID,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ReceivedAt holds the value of the "receivedAt" field.
+	ReceivedAt   time.Time `json:"receivedAt,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeadLetter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldID, deadletter.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case deadletter.FieldKind, deadletter.FieldCluster, deadletter.FieldRemoteAddr, deadletter.FieldReason, deadletter.FieldPayloadKeyID:
+			values[i] = new(sql.NullString)
+		case deadletter.FieldReceivedAt:
+			values[i] = new(sql.NullTime)
+		case deadletter.FieldPayload:
+			values[i] = deadletter.ValueScanner.Payload.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeadLetter fields.
+func (_m *DeadLetter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case deadletter.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case deadletter.FieldCluster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster", values[i])
+			} else if value.Valid {
+				_m.Cluster = value.String
+			}
+		case deadletter.FieldRemoteAddr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remoteAddr", values[i])
+			} else if value.Valid {
+				_m.RemoteAddr = value.String
+			}
+		case deadletter.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case deadletter.FieldPayload:
+			if value, err := deadletter.ValueScanner.Payload.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.Payload = value
+			}
+		case deadletter.FieldPayloadKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payloadKeyID", values[i])
+			} else if value.Valid {
+				_m.PayloadKeyID = value.String
+			}
+		case deadletter.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case deadletter.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field receivedAt", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeadLetter.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeadLetter) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeadLetter.
+// Note that you need to call DeadLetter.Unwrap() before calling this method if this DeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeadLetter) Update() *DeadLetterUpdateOne {
+	return NewDeadLetterClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeadLetter) Unwrap() *DeadLetter {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeadLetter is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("cluster=")
+	builder.WriteString(_m.Cluster)
+	builder.WriteString(", ")
+	builder.WriteString("remoteAddr=")
+	builder.WriteString(_m.RemoteAddr)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(_m.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("payloadKeyID=")
+	builder.WriteString(_m.PayloadKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("receivedAt=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeadLetters is a parsable slice of DeadLetter.
+type DeadLetters []*DeadLetter
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	// Label holds the string label denoting the deadletter type in the database.
+	Label = "dead_letter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCluster holds the string denoting the cluster field in the database.
+	FieldCluster = "cluster"
+	// FieldRemoteAddr holds the string denoting the remoteaddr field in the database.
+	FieldRemoteAddr = "remote_addr"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldPayloadKeyID holds the string denoting the payloadkeyid field in the database.
+	FieldPayloadKeyID = "payload_key_id"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldReceivedAt holds the string denoting the receivedat field in the database.
+	FieldReceivedAt = "received_at"
+	// Table holds the table name of the deadletter in the database.
+	Table = "dead_letters"
+)
+
+// Columns holds all SQL columns for deadletter fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldCluster,
+	FieldRemoteAddr,
+	FieldReason,
+	FieldPayload,
+	FieldPayloadKeyID,
+	FieldAttempts,
+	FieldReceivedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// DefaultCluster holds the default value on creation for the "cluster" field.
+	DefaultCluster string
+	// DefaultRemoteAddr holds the default value on creation for the "remoteAddr" field.
+	DefaultRemoteAddr string
+	// PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	PayloadValidator func(string) error
+	// DefaultPayloadKeyID holds the default value on creation for the "payloadKeyID" field.
+	DefaultPayloadKeyID string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultReceivedAt holds the default value on creation for the "receivedAt" field.
+	DefaultReceivedAt func() time.Time
+	// ValueScanner of all DeadLetter fields.
+	ValueScanner struct {
+		Payload field.TypeValueScanner[string]
+	}
+)
+
+// OrderOption defines the ordering options for the DeadLetter queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCluster orders the results by the cluster field.
+func ByCluster(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCluster, opts...).ToFunc()
+}
+
+// ByRemoteAddr orders the results by the remoteAddr field.
+func ByRemoteAddr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteAddr, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// ByPayloadKeyID orders the results by the payloadKeyID field.
+func ByPayloadKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayloadKeyID, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the receivedAt field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
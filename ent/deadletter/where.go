@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldKind, v))
+}
+
+// Cluster applies equality check predicate on the "cluster" field. It's identical to ClusterEQ.
+func Cluster(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldCluster, v))
+}
+
+// RemoteAddr applies equality check predicate on the "remoteAddr" field. It's identical to RemoteAddrEQ.
+func RemoteAddr(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldRemoteAddr, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldReason, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldEQ(FieldPayload, vc), err)
+}
+
+// PayloadKeyID applies equality check predicate on the "payloadKeyID" field. It's identical to PayloadKeyIDEQ.
+func PayloadKeyID(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldPayloadKeyID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ReceivedAt applies equality check predicate on the "receivedAt" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ClusterEQ applies the EQ predicate on the "cluster" field.
+func ClusterEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldCluster, v))
+}
+
+// ClusterNEQ applies the NEQ predicate on the "cluster" field.
+func ClusterNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldCluster, v))
+}
+
+// ClusterIn applies the In predicate on the "cluster" field.
+func ClusterIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldCluster, vs...))
+}
+
+// ClusterNotIn applies the NotIn predicate on the "cluster" field.
+func ClusterNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldCluster, vs...))
+}
+
+// ClusterGT applies the GT predicate on the "cluster" field.
+func ClusterGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldCluster, v))
+}
+
+// ClusterGTE applies the GTE predicate on the "cluster" field.
+func ClusterGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldCluster, v))
+}
+
+// ClusterLT applies the LT predicate on the "cluster" field.
+func ClusterLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldCluster, v))
+}
+
+// ClusterLTE applies the LTE predicate on the "cluster" field.
+func ClusterLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldCluster, v))
+}
+
+// ClusterContains applies the Contains predicate on the "cluster" field.
+func ClusterContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldCluster, v))
+}
+
+// ClusterHasPrefix applies the HasPrefix predicate on the "cluster" field.
+func ClusterHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldCluster, v))
+}
+
+// ClusterHasSuffix applies the HasSuffix predicate on the "cluster" field.
+func ClusterHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldCluster, v))
+}
+
+// ClusterEqualFold applies the EqualFold predicate on the "cluster" field.
+func ClusterEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldCluster, v))
+}
+
+// ClusterContainsFold applies the ContainsFold predicate on the "cluster" field.
+func ClusterContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldCluster, v))
+}
+
+// RemoteAddrEQ applies the EQ predicate on the "remoteAddr" field.
+func RemoteAddrEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldRemoteAddr, v))
+}
+
+// RemoteAddrNEQ applies the NEQ predicate on the "remoteAddr" field.
+func RemoteAddrNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldRemoteAddr, v))
+}
+
+// RemoteAddrIn applies the In predicate on the "remoteAddr" field.
+func RemoteAddrIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldRemoteAddr, vs...))
+}
+
+// RemoteAddrNotIn applies the NotIn predicate on the "remoteAddr" field.
+func RemoteAddrNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldRemoteAddr, vs...))
+}
+
+// RemoteAddrGT applies the GT predicate on the "remoteAddr" field.
+func RemoteAddrGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldRemoteAddr, v))
+}
+
+// RemoteAddrGTE applies the GTE predicate on the "remoteAddr" field.
+func RemoteAddrGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldRemoteAddr, v))
+}
+
+// RemoteAddrLT applies the LT predicate on the "remoteAddr" field.
+func RemoteAddrLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldRemoteAddr, v))
+}
+
+// RemoteAddrLTE applies the LTE predicate on the "remoteAddr" field.
+func RemoteAddrLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldRemoteAddr, v))
+}
+
+// RemoteAddrContains applies the Contains predicate on the "remoteAddr" field.
+func RemoteAddrContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldRemoteAddr, v))
+}
+
+// RemoteAddrHasPrefix applies the HasPrefix predicate on the "remoteAddr" field.
+func RemoteAddrHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldRemoteAddr, v))
+}
+
+// RemoteAddrHasSuffix applies the HasSuffix predicate on the "remoteAddr" field.
+func RemoteAddrHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldRemoteAddr, v))
+}
+
+// RemoteAddrEqualFold applies the EqualFold predicate on the "remoteAddr" field.
+func RemoteAddrEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldRemoteAddr, v))
+}
+
+// RemoteAddrContainsFold applies the ContainsFold predicate on the "remoteAddr" field.
+func RemoteAddrContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldRemoteAddr, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldReason, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldEQ(FieldPayload, vc), err)
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldNEQ(FieldPayload, vc), err)
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.DeadLetter {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Payload.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.DeadLetterOrErr(sql.FieldIn(FieldPayload, v...), err)
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.DeadLetter {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Payload.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.DeadLetterOrErr(sql.FieldNotIn(FieldPayload, v...), err)
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldGT(FieldPayload, vc), err)
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldGTE(FieldPayload, vc), err)
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldLT(FieldPayload, vc), err)
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	return predicate.DeadLetterOrErr(sql.FieldLTE(FieldPayload, vc), err)
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("payload value is not a string: %T", vc)
+	}
+	return predicate.DeadLetterOrErr(sql.FieldContains(FieldPayload, vcs), err)
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("payload value is not a string: %T", vc)
+	}
+	return predicate.DeadLetterOrErr(sql.FieldHasPrefix(FieldPayload, vcs), err)
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("payload value is not a string: %T", vc)
+	}
+	return predicate.DeadLetterOrErr(sql.FieldHasSuffix(FieldPayload, vcs), err)
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("payload value is not a string: %T", vc)
+	}
+	return predicate.DeadLetterOrErr(sql.FieldEqualFold(FieldPayload, vcs), err)
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.DeadLetter {
+	vc, err := ValueScanner.Payload.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("payload value is not a string: %T", vc)
+	}
+	return predicate.DeadLetterOrErr(sql.FieldContainsFold(FieldPayload, vcs), err)
+}
+
+// PayloadKeyIDEQ applies the EQ predicate on the "payloadKeyID" field.
+func PayloadKeyIDEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDNEQ applies the NEQ predicate on the "payloadKeyID" field.
+func PayloadKeyIDNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDIn applies the In predicate on the "payloadKeyID" field.
+func PayloadKeyIDIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldPayloadKeyID, vs...))
+}
+
+// PayloadKeyIDNotIn applies the NotIn predicate on the "payloadKeyID" field.
+func PayloadKeyIDNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldPayloadKeyID, vs...))
+}
+
+// PayloadKeyIDGT applies the GT predicate on the "payloadKeyID" field.
+func PayloadKeyIDGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDGTE applies the GTE predicate on the "payloadKeyID" field.
+func PayloadKeyIDGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDLT applies the LT predicate on the "payloadKeyID" field.
+func PayloadKeyIDLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDLTE applies the LTE predicate on the "payloadKeyID" field.
+func PayloadKeyIDLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDContains applies the Contains predicate on the "payloadKeyID" field.
+func PayloadKeyIDContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDHasPrefix applies the HasPrefix predicate on the "payloadKeyID" field.
+func PayloadKeyIDHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDHasSuffix applies the HasSuffix predicate on the "payloadKeyID" field.
+func PayloadKeyIDHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDEqualFold applies the EqualFold predicate on the "payloadKeyID" field.
+func PayloadKeyIDEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldPayloadKeyID, v))
+}
+
+// PayloadKeyIDContainsFold applies the ContainsFold predicate on the "payloadKeyID" field.
+func PayloadKeyIDContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldPayloadKeyID, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "receivedAt" field.
+func ReceivedAtEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "receivedAt" field.
+func ReceivedAtNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "receivedAt" field.
+func ReceivedAtIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "receivedAt" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "receivedAt" field.
+func ReceivedAtGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "receivedAt" field.
+func ReceivedAtGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "receivedAt" field.
+func ReceivedAtLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "receivedAt" field.
+func ReceivedAtLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.NotPredicates(p))
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *DeadLetterCreate) SetKind(v string) *DeadLetterCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCluster sets the "cluster" field.
+func (_c *DeadLetterCreate) SetCluster(v string) *DeadLetterCreate {
+	_c.mutation.SetCluster(v)
+	return _c
+}
+
+// SetNillableCluster sets the "cluster" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableCluster(v *string) *DeadLetterCreate {
+	if v != nil {
+		_c.SetCluster(*v)
+	}
+	return _c
+}
+
+// SetRemoteAddr sets the "remoteAddr" field.
+func (_c *DeadLetterCreate) SetRemoteAddr(v string) *DeadLetterCreate {
+	_c.mutation.SetRemoteAddr(v)
+	return _c
+}
+
+// SetNillableRemoteAddr sets the "remoteAddr" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableRemoteAddr(v *string) *DeadLetterCreate {
+	if v != nil {
+		_c.SetRemoteAddr(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *DeadLetterCreate) SetReason(v string) *DeadLetterCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *DeadLetterCreate) SetPayload(v string) *DeadLetterCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetPayloadKeyID sets the "payloadKeyID" field.
+func (_c *DeadLetterCreate) SetPayloadKeyID(v string) *DeadLetterCreate {
+	_c.mutation.SetPayloadKeyID(v)
+	return _c
+}
+
+// SetNillablePayloadKeyID sets the "payloadKeyID" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillablePayloadKeyID(v *string) *DeadLetterCreate {
+	if v != nil {
+		_c.SetPayloadKeyID(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DeadLetterCreate) SetAttempts(v int) *DeadLetterCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableAttempts(v *int) *DeadLetterCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "receivedAt" field.
+func (_c *DeadLetterCreate) SetReceivedAt(v time.Time) *DeadLetterCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "receivedAt" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableReceivedAt(v *time.Time) *DeadLetterCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_c *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return _c.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (_c *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeadLetterCreate) defaults() {
+	if _, ok := _c.mutation.Cluster(); !ok {
+		v := deadletter.DefaultCluster
+		_c.mutation.SetCluster(v)
+	}
+	if _, ok := _c.mutation.RemoteAddr(); !ok {
+		v := deadletter.DefaultRemoteAddr
+		_c.mutation.SetRemoteAddr(v)
+	}
+	if _, ok := _c.mutation.PayloadKeyID(); !ok {
+		v := deadletter.DefaultPayloadKeyID
+		_c.mutation.SetPayloadKeyID(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := deadletter.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := deadletter.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeadLetterCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DeadLetter.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := deadletter.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cluster(); !ok {
+		return &ValidationError{Name: "cluster", err: errors.New(`ent: missing required field "DeadLetter.cluster"`)}
+	}
+	if _, ok := _c.mutation.RemoteAddr(); !ok {
+		return &ValidationError{Name: "remoteAddr", err: errors.New(`ent: missing required field "DeadLetter.remoteAddr"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "DeadLetter.reason"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "DeadLetter.payload"`)}
+	}
+	if v, ok := _c.mutation.Payload(); ok {
+		if err := deadletter.PayloadValidator(v); err != nil {
+			return &ValidationError{Name: "payload", err: fmt.Errorf(`ent: validator failed for field "DeadLetter.payload": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PayloadKeyID(); !ok {
+		return &ValidationError{Name: "payloadKeyID", err: errors.New(`ent: missing required field "DeadLetter.payloadKeyID"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DeadLetter.attempts"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "receivedAt", err: errors.New(`ent: missing required field "DeadLetter.receivedAt"`)}
+	}
+	return nil
+}
+
+func (_c *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := _c.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &DeadLetter{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(deadletter.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Cluster(); ok {
+		_spec.SetField(deadletter.FieldCluster, field.TypeString, value)
+		_node.Cluster = value
+	}
+	if value, ok := _c.mutation.RemoteAddr(); ok {
+		_spec.SetField(deadletter.FieldRemoteAddr, field.TypeString, value)
+		_node.RemoteAddr = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(deadletter.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		vv, err := deadletter.ValueScanner.Payload.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(deadletter.FieldPayload, field.TypeString, vv)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.PayloadKeyID(); ok {
+		_spec.SetField(deadletter.FieldPayloadKeyID, field.TypeString, value)
+		_node.PayloadKeyID = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(deadletter.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	return _node, _spec, nil
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+}
+
+// Save creates the DeadLetter entities in the database.
+func (_c *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeadLetter, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
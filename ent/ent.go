@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/view"
 )
@@ -75,9 +77,11 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditevent.Table:   auditevent.ValidColumn,
-			resourcekind.Table: resourcekind.ValidColumn,
-			view.Table:         view.ValidColumn,
+			auditannotation.Table: auditannotation.ValidColumn,
+			auditevent.Table:      auditevent.ValidColumn,
+			deadletter.Table:      deadletter.ValidColumn,
+			resourcekind.Table:    resourcekind.ValidColumn,
+			view.Table:            view.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
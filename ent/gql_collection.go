@@ -7,10 +7,95 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *AuditAnnotationQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditAnnotationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *AuditAnnotationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditannotation.Columns))
+		selectedFields = []string{auditannotation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "event":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AuditEventClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, auditeventImplementors)...); err != nil {
+				return err
+			}
+			_q.withEvent = query
+		case "key":
+			if _, ok := fieldSeen[auditannotation.FieldKey]; !ok {
+				selectedFields = append(selectedFields, auditannotation.FieldKey)
+				fieldSeen[auditannotation.FieldKey] = struct{}{}
+			}
+		case "value":
+			if _, ok := fieldSeen[auditannotation.FieldValue]; !ok {
+				selectedFields = append(selectedFields, auditannotation.FieldValue)
+				fieldSeen[auditannotation.FieldValue] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditannotationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditAnnotationPaginateOption
+}
+
+func newAuditAnnotationPaginateArgs(rv map[string]any) *auditannotationPaginateArgs {
+	args := &auditannotationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*AuditAnnotationWhereInput); ok {
+		args.opts = append(args.opts, WithAuditAnnotationFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *AuditEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditEventQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -32,6 +117,19 @@ func (_q *AuditEventQuery) collectField(ctx context.Context, oneNode bool, opCtx
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
+
+		case "annotations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AuditAnnotationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, auditannotationImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedAnnotations(alias, func(wq *AuditAnnotationQuery) {
+				*wq = *query
+			})
 		case "raw":
 			if _, ok := fieldSeen[auditevent.FieldRaw]; !ok {
 				selectedFields = append(selectedFields, auditevent.FieldRaw)
@@ -102,6 +200,56 @@ func (_q *AuditEventQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, auditevent.FieldStage)
 				fieldSeen[auditevent.FieldStage] = struct{}{}
 			}
+		case "cluster":
+			if _, ok := fieldSeen[auditevent.FieldCluster]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldCluster)
+				fieldSeen[auditevent.FieldCluster] = struct{}{}
+			}
+		case "sampleweight":
+			if _, ok := fieldSeen[auditevent.FieldSampleWeight]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldSampleWeight)
+				fieldSeen[auditevent.FieldSampleWeight] = struct{}{}
+			}
+		case "username":
+			if _, ok := fieldSeen[auditevent.FieldUsername]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldUsername)
+				fieldSeen[auditevent.FieldUsername] = struct{}{}
+			}
+		case "usergroups":
+			if _, ok := fieldSeen[auditevent.FieldUserGroups]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldUserGroups)
+				fieldSeen[auditevent.FieldUserGroups] = struct{}{}
+			}
+		case "sourceips":
+			if _, ok := fieldSeen[auditevent.FieldSourceIPs]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldSourceIPs)
+				fieldSeen[auditevent.FieldSourceIPs] = struct{}{}
+			}
+		case "responsecode":
+			if _, ok := fieldSeen[auditevent.FieldResponseCode]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldResponseCode)
+				fieldSeen[auditevent.FieldResponseCode] = struct{}{}
+			}
+		case "impersonateduser":
+			if _, ok := fieldSeen[auditevent.FieldImpersonatedUser]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldImpersonatedUser)
+				fieldSeen[auditevent.FieldImpersonatedUser] = struct{}{}
+			}
+		case "useragentname":
+			if _, ok := fieldSeen[auditevent.FieldUserAgentName]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldUserAgentName)
+				fieldSeen[auditevent.FieldUserAgentName] = struct{}{}
+			}
+		case "rawsize":
+			if _, ok := fieldSeen[auditevent.FieldRawSize]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldRawSize)
+				fieldSeen[auditevent.FieldRawSize] = struct{}{}
+			}
+		case "rawkeyid":
+			if _, ok := fieldSeen[auditevent.FieldRawKeyID]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldRawKeyID)
+				fieldSeen[auditevent.FieldRawKeyID] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -165,6 +313,130 @@ func newAuditEventPaginateArgs(rv map[string]any) *auditeventPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *DeadLetterQuery) CollectFields(ctx context.Context, satisfies ...string) (*DeadLetterQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *DeadLetterQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(deadletter.Columns))
+		selectedFields = []string{deadletter.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "kind":
+			if _, ok := fieldSeen[deadletter.FieldKind]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldKind)
+				fieldSeen[deadletter.FieldKind] = struct{}{}
+			}
+		case "cluster":
+			if _, ok := fieldSeen[deadletter.FieldCluster]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldCluster)
+				fieldSeen[deadletter.FieldCluster] = struct{}{}
+			}
+		case "remoteaddr":
+			if _, ok := fieldSeen[deadletter.FieldRemoteAddr]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldRemoteAddr)
+				fieldSeen[deadletter.FieldRemoteAddr] = struct{}{}
+			}
+		case "reason":
+			if _, ok := fieldSeen[deadletter.FieldReason]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldReason)
+				fieldSeen[deadletter.FieldReason] = struct{}{}
+			}
+		case "payload":
+			if _, ok := fieldSeen[deadletter.FieldPayload]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldPayload)
+				fieldSeen[deadletter.FieldPayload] = struct{}{}
+			}
+		case "payloadkeyid":
+			if _, ok := fieldSeen[deadletter.FieldPayloadKeyID]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldPayloadKeyID)
+				fieldSeen[deadletter.FieldPayloadKeyID] = struct{}{}
+			}
+		case "attempts":
+			if _, ok := fieldSeen[deadletter.FieldAttempts]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldAttempts)
+				fieldSeen[deadletter.FieldAttempts] = struct{}{}
+			}
+		case "receivedat":
+			if _, ok := fieldSeen[deadletter.FieldReceivedAt]; !ok {
+				selectedFields = append(selectedFields, deadletter.FieldReceivedAt)
+				fieldSeen[deadletter.FieldReceivedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type deadletterPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DeadLetterPaginateOption
+}
+
+func newDeadLetterPaginateArgs(rv map[string]any) *deadletterPaginateArgs {
+	args := &deadletterPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &DeadLetterOrder{Field: &DeadLetterOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithDeadLetterOrder(order))
+			}
+		case *DeadLetterOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithDeadLetterOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*DeadLetterWhereInput); ok {
+		args.opts = append(args.opts, WithDeadLetterFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *ResourceKindQuery) CollectFields(ctx context.Context, satisfies ...string) (*ResourceKindQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
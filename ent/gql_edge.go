@@ -1,3 +1,29 @@
 // Code generated by ent, DO NOT EDIT.
 
 package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (_m *AuditAnnotation) Event(ctx context.Context) (*AuditEvent, error) {
+	result, err := _m.Edges.EventOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryEvent().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *AuditEvent) Annotations(ctx context.Context) (result []*AuditAnnotation, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedAnnotations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.AnnotationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAnnotations().All(ctx)
+	}
+	return result, err
+}
@@ -14,7 +14,9 @@ import (
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/hashicorp/go-multierror"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/view"
 	"golang.org/x/sync/semaphore"
@@ -25,11 +27,21 @@ type Noder interface {
 	IsNode()
 }
 
+var auditannotationImplementors = []string{"AuditAnnotation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*AuditAnnotation) IsNode() {}
+
 var auditeventImplementors = []string{"AuditEvent", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*AuditEvent) IsNode() {}
 
+var deadletterImplementors = []string{"DeadLetter", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*DeadLetter) IsNode() {}
+
 var resourcekindImplementors = []string{"ResourceKind", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -98,6 +110,15 @@ func (c *Client) Noder(ctx context.Context, id int, opts ...NodeOption) (_ Noder
 
 func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error) {
 	switch table {
+	case auditannotation.Table:
+		query := c.AuditAnnotation.Query().
+			Where(auditannotation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, auditannotationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case auditevent.Table:
 		query := c.AuditEvent.Query().
 			Where(auditevent.ID(id))
@@ -107,6 +128,15 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			}
 		}
 		return query.Only(ctx)
+	case deadletter.Table:
+		query := c.DeadLetter.Query().
+			Where(deadletter.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, deadletterImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case resourcekind.Table:
 		query := c.ResourceKind.Query().
 			Where(resourcekind.ID(id))
@@ -198,6 +228,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case auditannotation.Table:
+		query := c.AuditAnnotation.Query().
+			Where(auditannotation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, auditannotationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case auditevent.Table:
 		query := c.AuditEvent.Query().
 			Where(auditevent.IDIn(ids...))
@@ -214,6 +260,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case deadletter.Table:
+		query := c.DeadLetter.Query().
+			Where(deadletter.IDIn(ids...))
+		query, err := query.CollectFields(ctx, deadletterImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case resourcekind.Table:
 		query := c.ResourceKind.Query().
 			Where(resourcekind.IDIn(ids...))
@@ -14,7 +14,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/view"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -100,6 +102,255 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
+// AuditAnnotationEdge is the edge representation of AuditAnnotation.
+type AuditAnnotationEdge struct {
+	Node   *AuditAnnotation `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// AuditAnnotationConnection is the connection containing edges to AuditAnnotation.
+type AuditAnnotationConnection struct {
+	Edges      []*AuditAnnotationEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *AuditAnnotationConnection) build(nodes []*AuditAnnotation, pager *auditannotationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AuditAnnotation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AuditAnnotation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AuditAnnotation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AuditAnnotationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AuditAnnotationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AuditAnnotationPaginateOption enables pagination customization.
+type AuditAnnotationPaginateOption func(*auditannotationPager) error
+
+// WithAuditAnnotationOrder configures pagination ordering.
+func WithAuditAnnotationOrder(order *AuditAnnotationOrder) AuditAnnotationPaginateOption {
+	if order == nil {
+		order = DefaultAuditAnnotationOrder
+	}
+	o := *order
+	return func(pager *auditannotationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAuditAnnotationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAuditAnnotationFilter configures pagination filter.
+func WithAuditAnnotationFilter(filter func(*AuditAnnotationQuery) (*AuditAnnotationQuery, error)) AuditAnnotationPaginateOption {
+	return func(pager *auditannotationPager) error {
+		if filter == nil {
+			return errors.New("AuditAnnotationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type auditannotationPager struct {
+	reverse bool
+	order   *AuditAnnotationOrder
+	filter  func(*AuditAnnotationQuery) (*AuditAnnotationQuery, error)
+}
+
+func newAuditAnnotationPager(opts []AuditAnnotationPaginateOption, reverse bool) (*auditannotationPager, error) {
+	pager := &auditannotationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAuditAnnotationOrder
+	}
+	return pager, nil
+}
+
+func (p *auditannotationPager) applyFilter(query *AuditAnnotationQuery) (*AuditAnnotationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *auditannotationPager) toCursor(_m *AuditAnnotation) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *auditannotationPager) applyCursors(query *AuditAnnotationQuery, after, before *Cursor) (*AuditAnnotationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAuditAnnotationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *auditannotationPager) applyOrder(query *AuditAnnotationQuery) *AuditAnnotationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAuditAnnotationOrder.Field {
+		query = query.Order(DefaultAuditAnnotationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *auditannotationPager) orderExpr(query *AuditAnnotationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAuditAnnotationOrder.Field {
+			b.Comma().Ident(DefaultAuditAnnotationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AuditAnnotation.
+func (_m *AuditAnnotationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AuditAnnotationPaginateOption,
+) (*AuditAnnotationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAuditAnnotationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &AuditAnnotationConnection{Edges: []*AuditAnnotationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// AuditAnnotationOrderField defines the ordering field of AuditAnnotation.
+type AuditAnnotationOrderField struct {
+	// Value extracts the ordering value from the given AuditAnnotation.
+	Value    func(*AuditAnnotation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) auditannotation.OrderOption
+	toCursor func(*AuditAnnotation) Cursor
+}
+
+// AuditAnnotationOrder defines the ordering of AuditAnnotation.
+type AuditAnnotationOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *AuditAnnotationOrderField `json:"field"`
+}
+
+// DefaultAuditAnnotationOrder is the default ordering of AuditAnnotation.
+var DefaultAuditAnnotationOrder = &AuditAnnotationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AuditAnnotationOrderField{
+		Value: func(_m *AuditAnnotation) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: auditannotation.FieldID,
+		toTerm: auditannotation.ByID,
+		toCursor: func(_m *AuditAnnotation) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts AuditAnnotation into AuditAnnotationEdge.
+func (_m *AuditAnnotation) ToEdge(order *AuditAnnotationOrder) *AuditAnnotationEdge {
+	if order == nil {
+		order = DefaultAuditAnnotationOrder
+	}
+	return &AuditAnnotationEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
 // AuditEventEdge is the edge representation of AuditEvent.
 type AuditEventEdge struct {
 	Node   *AuditEvent `json:"node"`
@@ -414,6 +665,302 @@ func (_m *AuditEvent) ToEdge(order *AuditEventOrder) *AuditEventEdge {
 	}
 }
 
+// DeadLetterEdge is the edge representation of DeadLetter.
+type DeadLetterEdge struct {
+	Node   *DeadLetter `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// DeadLetterConnection is the connection containing edges to DeadLetter.
+type DeadLetterConnection struct {
+	Edges      []*DeadLetterEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *DeadLetterConnection) build(nodes []*DeadLetter, pager *deadletterPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *DeadLetter
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *DeadLetter {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *DeadLetter {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*DeadLetterEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &DeadLetterEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// DeadLetterPaginateOption enables pagination customization.
+type DeadLetterPaginateOption func(*deadletterPager) error
+
+// WithDeadLetterOrder configures pagination ordering.
+func WithDeadLetterOrder(order *DeadLetterOrder) DeadLetterPaginateOption {
+	if order == nil {
+		order = DefaultDeadLetterOrder
+	}
+	o := *order
+	return func(pager *deadletterPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultDeadLetterOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithDeadLetterFilter configures pagination filter.
+func WithDeadLetterFilter(filter func(*DeadLetterQuery) (*DeadLetterQuery, error)) DeadLetterPaginateOption {
+	return func(pager *deadletterPager) error {
+		if filter == nil {
+			return errors.New("DeadLetterQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type deadletterPager struct {
+	reverse bool
+	order   *DeadLetterOrder
+	filter  func(*DeadLetterQuery) (*DeadLetterQuery, error)
+}
+
+func newDeadLetterPager(opts []DeadLetterPaginateOption, reverse bool) (*deadletterPager, error) {
+	pager := &deadletterPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultDeadLetterOrder
+	}
+	return pager, nil
+}
+
+func (p *deadletterPager) applyFilter(query *DeadLetterQuery) (*DeadLetterQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *deadletterPager) toCursor(_m *DeadLetter) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *deadletterPager) applyCursors(query *DeadLetterQuery, after, before *Cursor) (*DeadLetterQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDeadLetterOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *deadletterPager) applyOrder(query *DeadLetterQuery) *DeadLetterQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultDeadLetterOrder.Field {
+		query = query.Order(DefaultDeadLetterOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *deadletterPager) orderExpr(query *DeadLetterQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultDeadLetterOrder.Field {
+			b.Comma().Ident(DefaultDeadLetterOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to DeadLetter.
+func (_m *DeadLetterQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DeadLetterPaginateOption,
+) (*DeadLetterConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDeadLetterPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &DeadLetterConnection{Edges: []*DeadLetterEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DeadLetterOrderFieldReceivedAt orders DeadLetter by receivedAt.
+	DeadLetterOrderFieldReceivedAt = &DeadLetterOrderField{
+		Value: func(_m *DeadLetter) (ent.Value, error) {
+			return _m.ReceivedAt, nil
+		},
+		column: deadletter.FieldReceivedAt,
+		toTerm: deadletter.ByReceivedAt,
+		toCursor: func(_m *DeadLetter) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ReceivedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DeadLetterOrderField) String() string {
+	var str string
+	switch f.column {
+	case DeadLetterOrderFieldReceivedAt.column:
+		str = "RECEIVED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DeadLetterOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DeadLetterOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DeadLetterOrderField %T must be a string", v)
+	}
+	switch str {
+	case "RECEIVED_AT":
+		*f = *DeadLetterOrderFieldReceivedAt
+	default:
+		return fmt.Errorf("%s is not a valid DeadLetterOrderField", str)
+	}
+	return nil
+}
+
+// DeadLetterOrderField defines the ordering field of DeadLetter.
+type DeadLetterOrderField struct {
+	// Value extracts the ordering value from the given DeadLetter.
+	Value    func(*DeadLetter) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) deadletter.OrderOption
+	toCursor func(*DeadLetter) Cursor
+}
+
+// DeadLetterOrder defines the ordering of DeadLetter.
+type DeadLetterOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *DeadLetterOrderField `json:"field"`
+}
+
+// DefaultDeadLetterOrder is the default ordering of DeadLetter.
+var DefaultDeadLetterOrder = &DeadLetterOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DeadLetterOrderField{
+		Value: func(_m *DeadLetter) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: deadletter.FieldID,
+		toTerm: deadletter.ByID,
+		toCursor: func(_m *DeadLetter) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts DeadLetter into DeadLetterEdge.
+func (_m *DeadLetter) ToEdge(order *DeadLetterOrder) *DeadLetterEdge {
+	if order == nil {
+		order = DefaultDeadLetterOrder
+	}
+	return &DeadLetterEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
 // ResourceKindEdge is the edge representation of ResourceKind.
 type ResourceKindEdge struct {
 	Node   *ResourceKind `json:"node"`
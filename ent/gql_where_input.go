@@ -7,12 +7,268 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/view"
 )
 
+// AuditAnnotationWhereInput represents a where input for filtering AuditAnnotation queries.
+type AuditAnnotationWhereInput struct {
+	Predicates []predicate.AuditAnnotation  `json:"-"`
+	Not        *AuditAnnotationWhereInput   `json:"not,omitempty"`
+	Or         []*AuditAnnotationWhereInput `json:"or,omitempty"`
+	And        []*AuditAnnotationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "key" field predicates.
+	Key             *string  `json:"key,omitempty"`
+	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
+	KeyIn           []string `json:"keyIn,omitempty"`
+	KeyNotIn        []string `json:"keyNotIn,omitempty"`
+	KeyGT           *string  `json:"keyGT,omitempty"`
+	KeyGTE          *string  `json:"keyGTE,omitempty"`
+	KeyLT           *string  `json:"keyLT,omitempty"`
+	KeyLTE          *string  `json:"keyLTE,omitempty"`
+	KeyContains     *string  `json:"keyContains,omitempty"`
+	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
+	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
+	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
+	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+
+	// "event" edge predicates.
+	HasEvent     *bool                   `json:"hasEvent,omitempty"`
+	HasEventWith []*AuditEventWhereInput `json:"hasEventWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AuditAnnotationWhereInput) AddPredicates(predicates ...predicate.AuditAnnotation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AuditAnnotationWhereInput filter on the AuditAnnotationQuery builder.
+func (i *AuditAnnotationWhereInput) Filter(q *AuditAnnotationQuery) (*AuditAnnotationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAuditAnnotationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAuditAnnotationWhereInput is returned in case the AuditAnnotationWhereInput is empty.
+var ErrEmptyAuditAnnotationWhereInput = errors.New("ent: empty predicate AuditAnnotationWhereInput")
+
+// P returns a predicate for filtering auditannotations.
+// An error is returned if the input is empty or invalid.
+func (i *AuditAnnotationWhereInput) P() (predicate.AuditAnnotation, error) {
+	var predicates []predicate.AuditAnnotation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, auditannotation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.AuditAnnotation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, auditannotation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.AuditAnnotation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, auditannotation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, auditannotation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, auditannotation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, auditannotation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, auditannotation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, auditannotation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, auditannotation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, auditannotation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, auditannotation.IDLTE(*i.IDLTE))
+	}
+	if i.Key != nil {
+		predicates = append(predicates, auditannotation.KeyEQ(*i.Key))
+	}
+	if i.KeyNEQ != nil {
+		predicates = append(predicates, auditannotation.KeyNEQ(*i.KeyNEQ))
+	}
+	if len(i.KeyIn) > 0 {
+		predicates = append(predicates, auditannotation.KeyIn(i.KeyIn...))
+	}
+	if len(i.KeyNotIn) > 0 {
+		predicates = append(predicates, auditannotation.KeyNotIn(i.KeyNotIn...))
+	}
+	if i.KeyGT != nil {
+		predicates = append(predicates, auditannotation.KeyGT(*i.KeyGT))
+	}
+	if i.KeyGTE != nil {
+		predicates = append(predicates, auditannotation.KeyGTE(*i.KeyGTE))
+	}
+	if i.KeyLT != nil {
+		predicates = append(predicates, auditannotation.KeyLT(*i.KeyLT))
+	}
+	if i.KeyLTE != nil {
+		predicates = append(predicates, auditannotation.KeyLTE(*i.KeyLTE))
+	}
+	if i.KeyContains != nil {
+		predicates = append(predicates, auditannotation.KeyContains(*i.KeyContains))
+	}
+	if i.KeyHasPrefix != nil {
+		predicates = append(predicates, auditannotation.KeyHasPrefix(*i.KeyHasPrefix))
+	}
+	if i.KeyHasSuffix != nil {
+		predicates = append(predicates, auditannotation.KeyHasSuffix(*i.KeyHasSuffix))
+	}
+	if i.KeyEqualFold != nil {
+		predicates = append(predicates, auditannotation.KeyEqualFold(*i.KeyEqualFold))
+	}
+	if i.KeyContainsFold != nil {
+		predicates = append(predicates, auditannotation.KeyContainsFold(*i.KeyContainsFold))
+	}
+	if i.Value != nil {
+		predicates = append(predicates, auditannotation.ValueEQ(*i.Value))
+	}
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, auditannotation.ValueNEQ(*i.ValueNEQ))
+	}
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, auditannotation.ValueIn(i.ValueIn...))
+	}
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, auditannotation.ValueNotIn(i.ValueNotIn...))
+	}
+	if i.ValueGT != nil {
+		predicates = append(predicates, auditannotation.ValueGT(*i.ValueGT))
+	}
+	if i.ValueGTE != nil {
+		predicates = append(predicates, auditannotation.ValueGTE(*i.ValueGTE))
+	}
+	if i.ValueLT != nil {
+		predicates = append(predicates, auditannotation.ValueLT(*i.ValueLT))
+	}
+	if i.ValueLTE != nil {
+		predicates = append(predicates, auditannotation.ValueLTE(*i.ValueLTE))
+	}
+	if i.ValueContains != nil {
+		predicates = append(predicates, auditannotation.ValueContains(*i.ValueContains))
+	}
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, auditannotation.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, auditannotation.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, auditannotation.ValueEqualFold(*i.ValueEqualFold))
+	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, auditannotation.ValueContainsFold(*i.ValueContainsFold))
+	}
+
+	if i.HasEvent != nil {
+		p := auditannotation.HasEvent()
+		if !*i.HasEvent {
+			p = auditannotation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasEventWith) > 0 {
+		with := make([]predicate.AuditEvent, 0, len(i.HasEventWith))
+		for _, w := range i.HasEventWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasEventWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, auditannotation.HasEventWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAuditAnnotationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return auditannotation.And(predicates...), nil
+	}
+}
+
 // AuditEventWhereInput represents a where input for filtering AuditEvent queries.
 type AuditEventWhereInput struct {
 	Predicates []predicate.AuditEvent  `json:"-"`
@@ -30,21 +286,6 @@ type AuditEventWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "raw" field predicates.
-	Raw             *string  `json:"raw,omitempty"`
-	RawNEQ          *string  `json:"rawNEQ,omitempty"`
-	RawIn           []string `json:"rawIn,omitempty"`
-	RawNotIn        []string `json:"rawNotIn,omitempty"`
-	RawGT           *string  `json:"rawGT,omitempty"`
-	RawGTE          *string  `json:"rawGTE,omitempty"`
-	RawLT           *string  `json:"rawLT,omitempty"`
-	RawLTE          *string  `json:"rawLTE,omitempty"`
-	RawContains     *string  `json:"rawContains,omitempty"`
-	RawHasPrefix    *string  `json:"rawHasPrefix,omitempty"`
-	RawHasSuffix    *string  `json:"rawHasSuffix,omitempty"`
-	RawEqualFold    *string  `json:"rawEqualFold,omitempty"`
-	RawContainsFold *string  `json:"rawContainsFold,omitempty"`
-
 	// "level" field predicates.
 	Level             *string  `json:"level,omitempty"`
 	LevelNEQ          *string  `json:"levelNEQ,omitempty"`
@@ -229,6 +470,115 @@ type AuditEventWhereInput struct {
 	StageHasSuffix    *string  `json:"stageHasSuffix,omitempty"`
 	StageEqualFold    *string  `json:"stageEqualFold,omitempty"`
 	StageContainsFold *string  `json:"stageContainsFold,omitempty"`
+
+	// "cluster" field predicates.
+	Cluster             *string  `json:"cluster,omitempty"`
+	ClusterNEQ          *string  `json:"clusterNEQ,omitempty"`
+	ClusterIn           []string `json:"clusterIn,omitempty"`
+	ClusterNotIn        []string `json:"clusterNotIn,omitempty"`
+	ClusterGT           *string  `json:"clusterGT,omitempty"`
+	ClusterGTE          *string  `json:"clusterGTE,omitempty"`
+	ClusterLT           *string  `json:"clusterLT,omitempty"`
+	ClusterLTE          *string  `json:"clusterLTE,omitempty"`
+	ClusterContains     *string  `json:"clusterContains,omitempty"`
+	ClusterHasPrefix    *string  `json:"clusterHasPrefix,omitempty"`
+	ClusterHasSuffix    *string  `json:"clusterHasSuffix,omitempty"`
+	ClusterEqualFold    *string  `json:"clusterEqualFold,omitempty"`
+	ClusterContainsFold *string  `json:"clusterContainsFold,omitempty"`
+
+	// "sampleWeight" field predicates.
+	SampleWeight      *int  `json:"sampleweight,omitempty"`
+	SampleWeightNEQ   *int  `json:"sampleweightNEQ,omitempty"`
+	SampleWeightIn    []int `json:"sampleweightIn,omitempty"`
+	SampleWeightNotIn []int `json:"sampleweightNotIn,omitempty"`
+	SampleWeightGT    *int  `json:"sampleweightGT,omitempty"`
+	SampleWeightGTE   *int  `json:"sampleweightGTE,omitempty"`
+	SampleWeightLT    *int  `json:"sampleweightLT,omitempty"`
+	SampleWeightLTE   *int  `json:"sampleweightLTE,omitempty"`
+
+	// "username" field predicates.
+	Username             *string  `json:"username,omitempty"`
+	UsernameNEQ          *string  `json:"usernameNEQ,omitempty"`
+	UsernameIn           []string `json:"usernameIn,omitempty"`
+	UsernameNotIn        []string `json:"usernameNotIn,omitempty"`
+	UsernameGT           *string  `json:"usernameGT,omitempty"`
+	UsernameGTE          *string  `json:"usernameGTE,omitempty"`
+	UsernameLT           *string  `json:"usernameLT,omitempty"`
+	UsernameLTE          *string  `json:"usernameLTE,omitempty"`
+	UsernameContains     *string  `json:"usernameContains,omitempty"`
+	UsernameHasPrefix    *string  `json:"usernameHasPrefix,omitempty"`
+	UsernameHasSuffix    *string  `json:"usernameHasSuffix,omitempty"`
+	UsernameEqualFold    *string  `json:"usernameEqualFold,omitempty"`
+	UsernameContainsFold *string  `json:"usernameContainsFold,omitempty"`
+
+	// "responseCode" field predicates.
+	ResponseCode      *int  `json:"responsecode,omitempty"`
+	ResponseCodeNEQ   *int  `json:"responsecodeNEQ,omitempty"`
+	ResponseCodeIn    []int `json:"responsecodeIn,omitempty"`
+	ResponseCodeNotIn []int `json:"responsecodeNotIn,omitempty"`
+	ResponseCodeGT    *int  `json:"responsecodeGT,omitempty"`
+	ResponseCodeGTE   *int  `json:"responsecodeGTE,omitempty"`
+	ResponseCodeLT    *int  `json:"responsecodeLT,omitempty"`
+	ResponseCodeLTE   *int  `json:"responsecodeLTE,omitempty"`
+
+	// "impersonatedUser" field predicates.
+	ImpersonatedUser             *string  `json:"impersonateduser,omitempty"`
+	ImpersonatedUserNEQ          *string  `json:"impersonateduserNEQ,omitempty"`
+	ImpersonatedUserIn           []string `json:"impersonateduserIn,omitempty"`
+	ImpersonatedUserNotIn        []string `json:"impersonateduserNotIn,omitempty"`
+	ImpersonatedUserGT           *string  `json:"impersonateduserGT,omitempty"`
+	ImpersonatedUserGTE          *string  `json:"impersonateduserGTE,omitempty"`
+	ImpersonatedUserLT           *string  `json:"impersonateduserLT,omitempty"`
+	ImpersonatedUserLTE          *string  `json:"impersonateduserLTE,omitempty"`
+	ImpersonatedUserContains     *string  `json:"impersonateduserContains,omitempty"`
+	ImpersonatedUserHasPrefix    *string  `json:"impersonateduserHasPrefix,omitempty"`
+	ImpersonatedUserHasSuffix    *string  `json:"impersonateduserHasSuffix,omitempty"`
+	ImpersonatedUserEqualFold    *string  `json:"impersonateduserEqualFold,omitempty"`
+	ImpersonatedUserContainsFold *string  `json:"impersonateduserContainsFold,omitempty"`
+
+	// "userAgentName" field predicates.
+	UserAgentName             *string  `json:"useragentname,omitempty"`
+	UserAgentNameNEQ          *string  `json:"useragentnameNEQ,omitempty"`
+	UserAgentNameIn           []string `json:"useragentnameIn,omitempty"`
+	UserAgentNameNotIn        []string `json:"useragentnameNotIn,omitempty"`
+	UserAgentNameGT           *string  `json:"useragentnameGT,omitempty"`
+	UserAgentNameGTE          *string  `json:"useragentnameGTE,omitempty"`
+	UserAgentNameLT           *string  `json:"useragentnameLT,omitempty"`
+	UserAgentNameLTE          *string  `json:"useragentnameLTE,omitempty"`
+	UserAgentNameContains     *string  `json:"useragentnameContains,omitempty"`
+	UserAgentNameHasPrefix    *string  `json:"useragentnameHasPrefix,omitempty"`
+	UserAgentNameHasSuffix    *string  `json:"useragentnameHasSuffix,omitempty"`
+	UserAgentNameEqualFold    *string  `json:"useragentnameEqualFold,omitempty"`
+	UserAgentNameContainsFold *string  `json:"useragentnameContainsFold,omitempty"`
+
+	// "rawSize" field predicates.
+	RawSize      *int  `json:"rawsize,omitempty"`
+	RawSizeNEQ   *int  `json:"rawsizeNEQ,omitempty"`
+	RawSizeIn    []int `json:"rawsizeIn,omitempty"`
+	RawSizeNotIn []int `json:"rawsizeNotIn,omitempty"`
+	RawSizeGT    *int  `json:"rawsizeGT,omitempty"`
+	RawSizeGTE   *int  `json:"rawsizeGTE,omitempty"`
+	RawSizeLT    *int  `json:"rawsizeLT,omitempty"`
+	RawSizeLTE   *int  `json:"rawsizeLTE,omitempty"`
+
+	// "rawKeyID" field predicates.
+	RawKeyID             *string  `json:"rawkeyid,omitempty"`
+	RawKeyIDNEQ          *string  `json:"rawkeyidNEQ,omitempty"`
+	RawKeyIDIn           []string `json:"rawkeyidIn,omitempty"`
+	RawKeyIDNotIn        []string `json:"rawkeyidNotIn,omitempty"`
+	RawKeyIDGT           *string  `json:"rawkeyidGT,omitempty"`
+	RawKeyIDGTE          *string  `json:"rawkeyidGTE,omitempty"`
+	RawKeyIDLT           *string  `json:"rawkeyidLT,omitempty"`
+	RawKeyIDLTE          *string  `json:"rawkeyidLTE,omitempty"`
+	RawKeyIDContains     *string  `json:"rawkeyidContains,omitempty"`
+	RawKeyIDHasPrefix    *string  `json:"rawkeyidHasPrefix,omitempty"`
+	RawKeyIDHasSuffix    *string  `json:"rawkeyidHasSuffix,omitempty"`
+	RawKeyIDEqualFold    *string  `json:"rawkeyidEqualFold,omitempty"`
+	RawKeyIDContainsFold *string  `json:"rawkeyidContainsFold,omitempty"`
+
+	// "annotations" edge predicates.
+	HasAnnotations     *bool                        `json:"hasAnnotations,omitempty"`
+	HasAnnotationsWith []*AuditAnnotationWhereInput `json:"hasAnnotationsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -326,45 +676,6 @@ func (i *AuditEventWhereInput) P() (predicate.AuditEvent, error) {
 	if i.IDLTE != nil {
 		predicates = append(predicates, auditevent.IDLTE(*i.IDLTE))
 	}
-	if i.Raw != nil {
-		predicates = append(predicates, auditevent.RawEQ(*i.Raw))
-	}
-	if i.RawNEQ != nil {
-		predicates = append(predicates, auditevent.RawNEQ(*i.RawNEQ))
-	}
-	if len(i.RawIn) > 0 {
-		predicates = append(predicates, auditevent.RawIn(i.RawIn...))
-	}
-	if len(i.RawNotIn) > 0 {
-		predicates = append(predicates, auditevent.RawNotIn(i.RawNotIn...))
-	}
-	if i.RawGT != nil {
-		predicates = append(predicates, auditevent.RawGT(*i.RawGT))
-	}
-	if i.RawGTE != nil {
-		predicates = append(predicates, auditevent.RawGTE(*i.RawGTE))
-	}
-	if i.RawLT != nil {
-		predicates = append(predicates, auditevent.RawLT(*i.RawLT))
-	}
-	if i.RawLTE != nil {
-		predicates = append(predicates, auditevent.RawLTE(*i.RawLTE))
-	}
-	if i.RawContains != nil {
-		predicates = append(predicates, auditevent.RawContains(*i.RawContains))
-	}
-	if i.RawHasPrefix != nil {
-		predicates = append(predicates, auditevent.RawHasPrefix(*i.RawHasPrefix))
-	}
-	if i.RawHasSuffix != nil {
-		predicates = append(predicates, auditevent.RawHasSuffix(*i.RawHasSuffix))
-	}
-	if i.RawEqualFold != nil {
-		predicates = append(predicates, auditevent.RawEqualFold(*i.RawEqualFold))
-	}
-	if i.RawContainsFold != nil {
-		predicates = append(predicates, auditevent.RawContainsFold(*i.RawContainsFold))
-	}
 	if i.Level != nil {
 		predicates = append(predicates, auditevent.LevelEQ(*i.Level))
 	}
@@ -842,7 +1153,292 @@ func (i *AuditEventWhereInput) P() (predicate.AuditEvent, error) {
 	if i.StageContainsFold != nil {
 		predicates = append(predicates, auditevent.StageContainsFold(*i.StageContainsFold))
 	}
+	if i.Cluster != nil {
+		predicates = append(predicates, auditevent.ClusterEQ(*i.Cluster))
+	}
+	if i.ClusterNEQ != nil {
+		predicates = append(predicates, auditevent.ClusterNEQ(*i.ClusterNEQ))
+	}
+	if len(i.ClusterIn) > 0 {
+		predicates = append(predicates, auditevent.ClusterIn(i.ClusterIn...))
+	}
+	if len(i.ClusterNotIn) > 0 {
+		predicates = append(predicates, auditevent.ClusterNotIn(i.ClusterNotIn...))
+	}
+	if i.ClusterGT != nil {
+		predicates = append(predicates, auditevent.ClusterGT(*i.ClusterGT))
+	}
+	if i.ClusterGTE != nil {
+		predicates = append(predicates, auditevent.ClusterGTE(*i.ClusterGTE))
+	}
+	if i.ClusterLT != nil {
+		predicates = append(predicates, auditevent.ClusterLT(*i.ClusterLT))
+	}
+	if i.ClusterLTE != nil {
+		predicates = append(predicates, auditevent.ClusterLTE(*i.ClusterLTE))
+	}
+	if i.ClusterContains != nil {
+		predicates = append(predicates, auditevent.ClusterContains(*i.ClusterContains))
+	}
+	if i.ClusterHasPrefix != nil {
+		predicates = append(predicates, auditevent.ClusterHasPrefix(*i.ClusterHasPrefix))
+	}
+	if i.ClusterHasSuffix != nil {
+		predicates = append(predicates, auditevent.ClusterHasSuffix(*i.ClusterHasSuffix))
+	}
+	if i.ClusterEqualFold != nil {
+		predicates = append(predicates, auditevent.ClusterEqualFold(*i.ClusterEqualFold))
+	}
+	if i.ClusterContainsFold != nil {
+		predicates = append(predicates, auditevent.ClusterContainsFold(*i.ClusterContainsFold))
+	}
+	if i.SampleWeight != nil {
+		predicates = append(predicates, auditevent.SampleWeightEQ(*i.SampleWeight))
+	}
+	if i.SampleWeightNEQ != nil {
+		predicates = append(predicates, auditevent.SampleWeightNEQ(*i.SampleWeightNEQ))
+	}
+	if len(i.SampleWeightIn) > 0 {
+		predicates = append(predicates, auditevent.SampleWeightIn(i.SampleWeightIn...))
+	}
+	if len(i.SampleWeightNotIn) > 0 {
+		predicates = append(predicates, auditevent.SampleWeightNotIn(i.SampleWeightNotIn...))
+	}
+	if i.SampleWeightGT != nil {
+		predicates = append(predicates, auditevent.SampleWeightGT(*i.SampleWeightGT))
+	}
+	if i.SampleWeightGTE != nil {
+		predicates = append(predicates, auditevent.SampleWeightGTE(*i.SampleWeightGTE))
+	}
+	if i.SampleWeightLT != nil {
+		predicates = append(predicates, auditevent.SampleWeightLT(*i.SampleWeightLT))
+	}
+	if i.SampleWeightLTE != nil {
+		predicates = append(predicates, auditevent.SampleWeightLTE(*i.SampleWeightLTE))
+	}
+	if i.Username != nil {
+		predicates = append(predicates, auditevent.UsernameEQ(*i.Username))
+	}
+	if i.UsernameNEQ != nil {
+		predicates = append(predicates, auditevent.UsernameNEQ(*i.UsernameNEQ))
+	}
+	if len(i.UsernameIn) > 0 {
+		predicates = append(predicates, auditevent.UsernameIn(i.UsernameIn...))
+	}
+	if len(i.UsernameNotIn) > 0 {
+		predicates = append(predicates, auditevent.UsernameNotIn(i.UsernameNotIn...))
+	}
+	if i.UsernameGT != nil {
+		predicates = append(predicates, auditevent.UsernameGT(*i.UsernameGT))
+	}
+	if i.UsernameGTE != nil {
+		predicates = append(predicates, auditevent.UsernameGTE(*i.UsernameGTE))
+	}
+	if i.UsernameLT != nil {
+		predicates = append(predicates, auditevent.UsernameLT(*i.UsernameLT))
+	}
+	if i.UsernameLTE != nil {
+		predicates = append(predicates, auditevent.UsernameLTE(*i.UsernameLTE))
+	}
+	if i.UsernameContains != nil {
+		predicates = append(predicates, auditevent.UsernameContains(*i.UsernameContains))
+	}
+	if i.UsernameHasPrefix != nil {
+		predicates = append(predicates, auditevent.UsernameHasPrefix(*i.UsernameHasPrefix))
+	}
+	if i.UsernameHasSuffix != nil {
+		predicates = append(predicates, auditevent.UsernameHasSuffix(*i.UsernameHasSuffix))
+	}
+	if i.UsernameEqualFold != nil {
+		predicates = append(predicates, auditevent.UsernameEqualFold(*i.UsernameEqualFold))
+	}
+	if i.UsernameContainsFold != nil {
+		predicates = append(predicates, auditevent.UsernameContainsFold(*i.UsernameContainsFold))
+	}
+	if i.ResponseCode != nil {
+		predicates = append(predicates, auditevent.ResponseCodeEQ(*i.ResponseCode))
+	}
+	if i.ResponseCodeNEQ != nil {
+		predicates = append(predicates, auditevent.ResponseCodeNEQ(*i.ResponseCodeNEQ))
+	}
+	if len(i.ResponseCodeIn) > 0 {
+		predicates = append(predicates, auditevent.ResponseCodeIn(i.ResponseCodeIn...))
+	}
+	if len(i.ResponseCodeNotIn) > 0 {
+		predicates = append(predicates, auditevent.ResponseCodeNotIn(i.ResponseCodeNotIn...))
+	}
+	if i.ResponseCodeGT != nil {
+		predicates = append(predicates, auditevent.ResponseCodeGT(*i.ResponseCodeGT))
+	}
+	if i.ResponseCodeGTE != nil {
+		predicates = append(predicates, auditevent.ResponseCodeGTE(*i.ResponseCodeGTE))
+	}
+	if i.ResponseCodeLT != nil {
+		predicates = append(predicates, auditevent.ResponseCodeLT(*i.ResponseCodeLT))
+	}
+	if i.ResponseCodeLTE != nil {
+		predicates = append(predicates, auditevent.ResponseCodeLTE(*i.ResponseCodeLTE))
+	}
+	if i.ImpersonatedUser != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserEQ(*i.ImpersonatedUser))
+	}
+	if i.ImpersonatedUserNEQ != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserNEQ(*i.ImpersonatedUserNEQ))
+	}
+	if len(i.ImpersonatedUserIn) > 0 {
+		predicates = append(predicates, auditevent.ImpersonatedUserIn(i.ImpersonatedUserIn...))
+	}
+	if len(i.ImpersonatedUserNotIn) > 0 {
+		predicates = append(predicates, auditevent.ImpersonatedUserNotIn(i.ImpersonatedUserNotIn...))
+	}
+	if i.ImpersonatedUserGT != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserGT(*i.ImpersonatedUserGT))
+	}
+	if i.ImpersonatedUserGTE != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserGTE(*i.ImpersonatedUserGTE))
+	}
+	if i.ImpersonatedUserLT != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserLT(*i.ImpersonatedUserLT))
+	}
+	if i.ImpersonatedUserLTE != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserLTE(*i.ImpersonatedUserLTE))
+	}
+	if i.ImpersonatedUserContains != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserContains(*i.ImpersonatedUserContains))
+	}
+	if i.ImpersonatedUserHasPrefix != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserHasPrefix(*i.ImpersonatedUserHasPrefix))
+	}
+	if i.ImpersonatedUserHasSuffix != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserHasSuffix(*i.ImpersonatedUserHasSuffix))
+	}
+	if i.ImpersonatedUserEqualFold != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserEqualFold(*i.ImpersonatedUserEqualFold))
+	}
+	if i.ImpersonatedUserContainsFold != nil {
+		predicates = append(predicates, auditevent.ImpersonatedUserContainsFold(*i.ImpersonatedUserContainsFold))
+	}
+	if i.UserAgentName != nil {
+		predicates = append(predicates, auditevent.UserAgentNameEQ(*i.UserAgentName))
+	}
+	if i.UserAgentNameNEQ != nil {
+		predicates = append(predicates, auditevent.UserAgentNameNEQ(*i.UserAgentNameNEQ))
+	}
+	if len(i.UserAgentNameIn) > 0 {
+		predicates = append(predicates, auditevent.UserAgentNameIn(i.UserAgentNameIn...))
+	}
+	if len(i.UserAgentNameNotIn) > 0 {
+		predicates = append(predicates, auditevent.UserAgentNameNotIn(i.UserAgentNameNotIn...))
+	}
+	if i.UserAgentNameGT != nil {
+		predicates = append(predicates, auditevent.UserAgentNameGT(*i.UserAgentNameGT))
+	}
+	if i.UserAgentNameGTE != nil {
+		predicates = append(predicates, auditevent.UserAgentNameGTE(*i.UserAgentNameGTE))
+	}
+	if i.UserAgentNameLT != nil {
+		predicates = append(predicates, auditevent.UserAgentNameLT(*i.UserAgentNameLT))
+	}
+	if i.UserAgentNameLTE != nil {
+		predicates = append(predicates, auditevent.UserAgentNameLTE(*i.UserAgentNameLTE))
+	}
+	if i.UserAgentNameContains != nil {
+		predicates = append(predicates, auditevent.UserAgentNameContains(*i.UserAgentNameContains))
+	}
+	if i.UserAgentNameHasPrefix != nil {
+		predicates = append(predicates, auditevent.UserAgentNameHasPrefix(*i.UserAgentNameHasPrefix))
+	}
+	if i.UserAgentNameHasSuffix != nil {
+		predicates = append(predicates, auditevent.UserAgentNameHasSuffix(*i.UserAgentNameHasSuffix))
+	}
+	if i.UserAgentNameEqualFold != nil {
+		predicates = append(predicates, auditevent.UserAgentNameEqualFold(*i.UserAgentNameEqualFold))
+	}
+	if i.UserAgentNameContainsFold != nil {
+		predicates = append(predicates, auditevent.UserAgentNameContainsFold(*i.UserAgentNameContainsFold))
+	}
+	if i.RawSize != nil {
+		predicates = append(predicates, auditevent.RawSizeEQ(*i.RawSize))
+	}
+	if i.RawSizeNEQ != nil {
+		predicates = append(predicates, auditevent.RawSizeNEQ(*i.RawSizeNEQ))
+	}
+	if len(i.RawSizeIn) > 0 {
+		predicates = append(predicates, auditevent.RawSizeIn(i.RawSizeIn...))
+	}
+	if len(i.RawSizeNotIn) > 0 {
+		predicates = append(predicates, auditevent.RawSizeNotIn(i.RawSizeNotIn...))
+	}
+	if i.RawSizeGT != nil {
+		predicates = append(predicates, auditevent.RawSizeGT(*i.RawSizeGT))
+	}
+	if i.RawSizeGTE != nil {
+		predicates = append(predicates, auditevent.RawSizeGTE(*i.RawSizeGTE))
+	}
+	if i.RawSizeLT != nil {
+		predicates = append(predicates, auditevent.RawSizeLT(*i.RawSizeLT))
+	}
+	if i.RawSizeLTE != nil {
+		predicates = append(predicates, auditevent.RawSizeLTE(*i.RawSizeLTE))
+	}
+	if i.RawKeyID != nil {
+		predicates = append(predicates, auditevent.RawKeyIDEQ(*i.RawKeyID))
+	}
+	if i.RawKeyIDNEQ != nil {
+		predicates = append(predicates, auditevent.RawKeyIDNEQ(*i.RawKeyIDNEQ))
+	}
+	if len(i.RawKeyIDIn) > 0 {
+		predicates = append(predicates, auditevent.RawKeyIDIn(i.RawKeyIDIn...))
+	}
+	if len(i.RawKeyIDNotIn) > 0 {
+		predicates = append(predicates, auditevent.RawKeyIDNotIn(i.RawKeyIDNotIn...))
+	}
+	if i.RawKeyIDGT != nil {
+		predicates = append(predicates, auditevent.RawKeyIDGT(*i.RawKeyIDGT))
+	}
+	if i.RawKeyIDGTE != nil {
+		predicates = append(predicates, auditevent.RawKeyIDGTE(*i.RawKeyIDGTE))
+	}
+	if i.RawKeyIDLT != nil {
+		predicates = append(predicates, auditevent.RawKeyIDLT(*i.RawKeyIDLT))
+	}
+	if i.RawKeyIDLTE != nil {
+		predicates = append(predicates, auditevent.RawKeyIDLTE(*i.RawKeyIDLTE))
+	}
+	if i.RawKeyIDContains != nil {
+		predicates = append(predicates, auditevent.RawKeyIDContains(*i.RawKeyIDContains))
+	}
+	if i.RawKeyIDHasPrefix != nil {
+		predicates = append(predicates, auditevent.RawKeyIDHasPrefix(*i.RawKeyIDHasPrefix))
+	}
+	if i.RawKeyIDHasSuffix != nil {
+		predicates = append(predicates, auditevent.RawKeyIDHasSuffix(*i.RawKeyIDHasSuffix))
+	}
+	if i.RawKeyIDEqualFold != nil {
+		predicates = append(predicates, auditevent.RawKeyIDEqualFold(*i.RawKeyIDEqualFold))
+	}
+	if i.RawKeyIDContainsFold != nil {
+		predicates = append(predicates, auditevent.RawKeyIDContainsFold(*i.RawKeyIDContainsFold))
+	}
 
+	if i.HasAnnotations != nil {
+		p := auditevent.HasAnnotations()
+		if !*i.HasAnnotations {
+			p = auditevent.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAnnotationsWith) > 0 {
+		with := make([]predicate.AuditAnnotation, 0, len(i.HasAnnotationsWith))
+		for _, w := range i.HasAnnotationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAnnotationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, auditevent.HasAnnotationsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyAuditEventWhereInput
@@ -853,6 +1449,468 @@ func (i *AuditEventWhereInput) P() (predicate.AuditEvent, error) {
 	}
 }
 
+// DeadLetterWhereInput represents a where input for filtering DeadLetter queries.
+type DeadLetterWhereInput struct {
+	Predicates []predicate.DeadLetter  `json:"-"`
+	Not        *DeadLetterWhereInput   `json:"not,omitempty"`
+	Or         []*DeadLetterWhereInput `json:"or,omitempty"`
+	And        []*DeadLetterWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "kind" field predicates.
+	Kind             *string  `json:"kind,omitempty"`
+	KindNEQ          *string  `json:"kindNEQ,omitempty"`
+	KindIn           []string `json:"kindIn,omitempty"`
+	KindNotIn        []string `json:"kindNotIn,omitempty"`
+	KindGT           *string  `json:"kindGT,omitempty"`
+	KindGTE          *string  `json:"kindGTE,omitempty"`
+	KindLT           *string  `json:"kindLT,omitempty"`
+	KindLTE          *string  `json:"kindLTE,omitempty"`
+	KindContains     *string  `json:"kindContains,omitempty"`
+	KindHasPrefix    *string  `json:"kindHasPrefix,omitempty"`
+	KindHasSuffix    *string  `json:"kindHasSuffix,omitempty"`
+	KindEqualFold    *string  `json:"kindEqualFold,omitempty"`
+	KindContainsFold *string  `json:"kindContainsFold,omitempty"`
+
+	// "cluster" field predicates.
+	Cluster             *string  `json:"cluster,omitempty"`
+	ClusterNEQ          *string  `json:"clusterNEQ,omitempty"`
+	ClusterIn           []string `json:"clusterIn,omitempty"`
+	ClusterNotIn        []string `json:"clusterNotIn,omitempty"`
+	ClusterGT           *string  `json:"clusterGT,omitempty"`
+	ClusterGTE          *string  `json:"clusterGTE,omitempty"`
+	ClusterLT           *string  `json:"clusterLT,omitempty"`
+	ClusterLTE          *string  `json:"clusterLTE,omitempty"`
+	ClusterContains     *string  `json:"clusterContains,omitempty"`
+	ClusterHasPrefix    *string  `json:"clusterHasPrefix,omitempty"`
+	ClusterHasSuffix    *string  `json:"clusterHasSuffix,omitempty"`
+	ClusterEqualFold    *string  `json:"clusterEqualFold,omitempty"`
+	ClusterContainsFold *string  `json:"clusterContainsFold,omitempty"`
+
+	// "remoteAddr" field predicates.
+	RemoteAddr             *string  `json:"remoteaddr,omitempty"`
+	RemoteAddrNEQ          *string  `json:"remoteaddrNEQ,omitempty"`
+	RemoteAddrIn           []string `json:"remoteaddrIn,omitempty"`
+	RemoteAddrNotIn        []string `json:"remoteaddrNotIn,omitempty"`
+	RemoteAddrGT           *string  `json:"remoteaddrGT,omitempty"`
+	RemoteAddrGTE          *string  `json:"remoteaddrGTE,omitempty"`
+	RemoteAddrLT           *string  `json:"remoteaddrLT,omitempty"`
+	RemoteAddrLTE          *string  `json:"remoteaddrLTE,omitempty"`
+	RemoteAddrContains     *string  `json:"remoteaddrContains,omitempty"`
+	RemoteAddrHasPrefix    *string  `json:"remoteaddrHasPrefix,omitempty"`
+	RemoteAddrHasSuffix    *string  `json:"remoteaddrHasSuffix,omitempty"`
+	RemoteAddrEqualFold    *string  `json:"remoteaddrEqualFold,omitempty"`
+	RemoteAddrContainsFold *string  `json:"remoteaddrContainsFold,omitempty"`
+
+	// "reason" field predicates.
+	Reason             *string  `json:"reason,omitempty"`
+	ReasonNEQ          *string  `json:"reasonNEQ,omitempty"`
+	ReasonIn           []string `json:"reasonIn,omitempty"`
+	ReasonNotIn        []string `json:"reasonNotIn,omitempty"`
+	ReasonGT           *string  `json:"reasonGT,omitempty"`
+	ReasonGTE          *string  `json:"reasonGTE,omitempty"`
+	ReasonLT           *string  `json:"reasonLT,omitempty"`
+	ReasonLTE          *string  `json:"reasonLTE,omitempty"`
+	ReasonContains     *string  `json:"reasonContains,omitempty"`
+	ReasonHasPrefix    *string  `json:"reasonHasPrefix,omitempty"`
+	ReasonHasSuffix    *string  `json:"reasonHasSuffix,omitempty"`
+	ReasonEqualFold    *string  `json:"reasonEqualFold,omitempty"`
+	ReasonContainsFold *string  `json:"reasonContainsFold,omitempty"`
+
+	// "payloadKeyID" field predicates.
+	PayloadKeyID             *string  `json:"payloadkeyid,omitempty"`
+	PayloadKeyIDNEQ          *string  `json:"payloadkeyidNEQ,omitempty"`
+	PayloadKeyIDIn           []string `json:"payloadkeyidIn,omitempty"`
+	PayloadKeyIDNotIn        []string `json:"payloadkeyidNotIn,omitempty"`
+	PayloadKeyIDGT           *string  `json:"payloadkeyidGT,omitempty"`
+	PayloadKeyIDGTE          *string  `json:"payloadkeyidGTE,omitempty"`
+	PayloadKeyIDLT           *string  `json:"payloadkeyidLT,omitempty"`
+	PayloadKeyIDLTE          *string  `json:"payloadkeyidLTE,omitempty"`
+	PayloadKeyIDContains     *string  `json:"payloadkeyidContains,omitempty"`
+	PayloadKeyIDHasPrefix    *string  `json:"payloadkeyidHasPrefix,omitempty"`
+	PayloadKeyIDHasSuffix    *string  `json:"payloadkeyidHasSuffix,omitempty"`
+	PayloadKeyIDEqualFold    *string  `json:"payloadkeyidEqualFold,omitempty"`
+	PayloadKeyIDContainsFold *string  `json:"payloadkeyidContainsFold,omitempty"`
+
+	// "attempts" field predicates.
+	Attempts      *int  `json:"attempts,omitempty"`
+	AttemptsNEQ   *int  `json:"attemptsNEQ,omitempty"`
+	AttemptsIn    []int `json:"attemptsIn,omitempty"`
+	AttemptsNotIn []int `json:"attemptsNotIn,omitempty"`
+	AttemptsGT    *int  `json:"attemptsGT,omitempty"`
+	AttemptsGTE   *int  `json:"attemptsGTE,omitempty"`
+	AttemptsLT    *int  `json:"attemptsLT,omitempty"`
+	AttemptsLTE   *int  `json:"attemptsLTE,omitempty"`
+
+	// "receivedAt" field predicates.
+	ReceivedAt      *time.Time  `json:"receivedat,omitempty"`
+	ReceivedAtNEQ   *time.Time  `json:"receivedatNEQ,omitempty"`
+	ReceivedAtIn    []time.Time `json:"receivedatIn,omitempty"`
+	ReceivedAtNotIn []time.Time `json:"receivedatNotIn,omitempty"`
+	ReceivedAtGT    *time.Time  `json:"receivedatGT,omitempty"`
+	ReceivedAtGTE   *time.Time  `json:"receivedatGTE,omitempty"`
+	ReceivedAtLT    *time.Time  `json:"receivedatLT,omitempty"`
+	ReceivedAtLTE   *time.Time  `json:"receivedatLTE,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *DeadLetterWhereInput) AddPredicates(predicates ...predicate.DeadLetter) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the DeadLetterWhereInput filter on the DeadLetterQuery builder.
+func (i *DeadLetterWhereInput) Filter(q *DeadLetterQuery) (*DeadLetterQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyDeadLetterWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyDeadLetterWhereInput is returned in case the DeadLetterWhereInput is empty.
+var ErrEmptyDeadLetterWhereInput = errors.New("ent: empty predicate DeadLetterWhereInput")
+
+// P returns a predicate for filtering deadletters.
+// An error is returned if the input is empty or invalid.
+func (i *DeadLetterWhereInput) P() (predicate.DeadLetter, error) {
+	var predicates []predicate.DeadLetter
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, deadletter.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.DeadLetter, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, deadletter.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.DeadLetter, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, deadletter.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, deadletter.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, deadletter.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, deadletter.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, deadletter.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, deadletter.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, deadletter.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, deadletter.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, deadletter.IDLTE(*i.IDLTE))
+	}
+	if i.Kind != nil {
+		predicates = append(predicates, deadletter.KindEQ(*i.Kind))
+	}
+	if i.KindNEQ != nil {
+		predicates = append(predicates, deadletter.KindNEQ(*i.KindNEQ))
+	}
+	if len(i.KindIn) > 0 {
+		predicates = append(predicates, deadletter.KindIn(i.KindIn...))
+	}
+	if len(i.KindNotIn) > 0 {
+		predicates = append(predicates, deadletter.KindNotIn(i.KindNotIn...))
+	}
+	if i.KindGT != nil {
+		predicates = append(predicates, deadletter.KindGT(*i.KindGT))
+	}
+	if i.KindGTE != nil {
+		predicates = append(predicates, deadletter.KindGTE(*i.KindGTE))
+	}
+	if i.KindLT != nil {
+		predicates = append(predicates, deadletter.KindLT(*i.KindLT))
+	}
+	if i.KindLTE != nil {
+		predicates = append(predicates, deadletter.KindLTE(*i.KindLTE))
+	}
+	if i.KindContains != nil {
+		predicates = append(predicates, deadletter.KindContains(*i.KindContains))
+	}
+	if i.KindHasPrefix != nil {
+		predicates = append(predicates, deadletter.KindHasPrefix(*i.KindHasPrefix))
+	}
+	if i.KindHasSuffix != nil {
+		predicates = append(predicates, deadletter.KindHasSuffix(*i.KindHasSuffix))
+	}
+	if i.KindEqualFold != nil {
+		predicates = append(predicates, deadletter.KindEqualFold(*i.KindEqualFold))
+	}
+	if i.KindContainsFold != nil {
+		predicates = append(predicates, deadletter.KindContainsFold(*i.KindContainsFold))
+	}
+	if i.Cluster != nil {
+		predicates = append(predicates, deadletter.ClusterEQ(*i.Cluster))
+	}
+	if i.ClusterNEQ != nil {
+		predicates = append(predicates, deadletter.ClusterNEQ(*i.ClusterNEQ))
+	}
+	if len(i.ClusterIn) > 0 {
+		predicates = append(predicates, deadletter.ClusterIn(i.ClusterIn...))
+	}
+	if len(i.ClusterNotIn) > 0 {
+		predicates = append(predicates, deadletter.ClusterNotIn(i.ClusterNotIn...))
+	}
+	if i.ClusterGT != nil {
+		predicates = append(predicates, deadletter.ClusterGT(*i.ClusterGT))
+	}
+	if i.ClusterGTE != nil {
+		predicates = append(predicates, deadletter.ClusterGTE(*i.ClusterGTE))
+	}
+	if i.ClusterLT != nil {
+		predicates = append(predicates, deadletter.ClusterLT(*i.ClusterLT))
+	}
+	if i.ClusterLTE != nil {
+		predicates = append(predicates, deadletter.ClusterLTE(*i.ClusterLTE))
+	}
+	if i.ClusterContains != nil {
+		predicates = append(predicates, deadletter.ClusterContains(*i.ClusterContains))
+	}
+	if i.ClusterHasPrefix != nil {
+		predicates = append(predicates, deadletter.ClusterHasPrefix(*i.ClusterHasPrefix))
+	}
+	if i.ClusterHasSuffix != nil {
+		predicates = append(predicates, deadletter.ClusterHasSuffix(*i.ClusterHasSuffix))
+	}
+	if i.ClusterEqualFold != nil {
+		predicates = append(predicates, deadletter.ClusterEqualFold(*i.ClusterEqualFold))
+	}
+	if i.ClusterContainsFold != nil {
+		predicates = append(predicates, deadletter.ClusterContainsFold(*i.ClusterContainsFold))
+	}
+	if i.RemoteAddr != nil {
+		predicates = append(predicates, deadletter.RemoteAddrEQ(*i.RemoteAddr))
+	}
+	if i.RemoteAddrNEQ != nil {
+		predicates = append(predicates, deadletter.RemoteAddrNEQ(*i.RemoteAddrNEQ))
+	}
+	if len(i.RemoteAddrIn) > 0 {
+		predicates = append(predicates, deadletter.RemoteAddrIn(i.RemoteAddrIn...))
+	}
+	if len(i.RemoteAddrNotIn) > 0 {
+		predicates = append(predicates, deadletter.RemoteAddrNotIn(i.RemoteAddrNotIn...))
+	}
+	if i.RemoteAddrGT != nil {
+		predicates = append(predicates, deadletter.RemoteAddrGT(*i.RemoteAddrGT))
+	}
+	if i.RemoteAddrGTE != nil {
+		predicates = append(predicates, deadletter.RemoteAddrGTE(*i.RemoteAddrGTE))
+	}
+	if i.RemoteAddrLT != nil {
+		predicates = append(predicates, deadletter.RemoteAddrLT(*i.RemoteAddrLT))
+	}
+	if i.RemoteAddrLTE != nil {
+		predicates = append(predicates, deadletter.RemoteAddrLTE(*i.RemoteAddrLTE))
+	}
+	if i.RemoteAddrContains != nil {
+		predicates = append(predicates, deadletter.RemoteAddrContains(*i.RemoteAddrContains))
+	}
+	if i.RemoteAddrHasPrefix != nil {
+		predicates = append(predicates, deadletter.RemoteAddrHasPrefix(*i.RemoteAddrHasPrefix))
+	}
+	if i.RemoteAddrHasSuffix != nil {
+		predicates = append(predicates, deadletter.RemoteAddrHasSuffix(*i.RemoteAddrHasSuffix))
+	}
+	if i.RemoteAddrEqualFold != nil {
+		predicates = append(predicates, deadletter.RemoteAddrEqualFold(*i.RemoteAddrEqualFold))
+	}
+	if i.RemoteAddrContainsFold != nil {
+		predicates = append(predicates, deadletter.RemoteAddrContainsFold(*i.RemoteAddrContainsFold))
+	}
+	if i.Reason != nil {
+		predicates = append(predicates, deadletter.ReasonEQ(*i.Reason))
+	}
+	if i.ReasonNEQ != nil {
+		predicates = append(predicates, deadletter.ReasonNEQ(*i.ReasonNEQ))
+	}
+	if len(i.ReasonIn) > 0 {
+		predicates = append(predicates, deadletter.ReasonIn(i.ReasonIn...))
+	}
+	if len(i.ReasonNotIn) > 0 {
+		predicates = append(predicates, deadletter.ReasonNotIn(i.ReasonNotIn...))
+	}
+	if i.ReasonGT != nil {
+		predicates = append(predicates, deadletter.ReasonGT(*i.ReasonGT))
+	}
+	if i.ReasonGTE != nil {
+		predicates = append(predicates, deadletter.ReasonGTE(*i.ReasonGTE))
+	}
+	if i.ReasonLT != nil {
+		predicates = append(predicates, deadletter.ReasonLT(*i.ReasonLT))
+	}
+	if i.ReasonLTE != nil {
+		predicates = append(predicates, deadletter.ReasonLTE(*i.ReasonLTE))
+	}
+	if i.ReasonContains != nil {
+		predicates = append(predicates, deadletter.ReasonContains(*i.ReasonContains))
+	}
+	if i.ReasonHasPrefix != nil {
+		predicates = append(predicates, deadletter.ReasonHasPrefix(*i.ReasonHasPrefix))
+	}
+	if i.ReasonHasSuffix != nil {
+		predicates = append(predicates, deadletter.ReasonHasSuffix(*i.ReasonHasSuffix))
+	}
+	if i.ReasonEqualFold != nil {
+		predicates = append(predicates, deadletter.ReasonEqualFold(*i.ReasonEqualFold))
+	}
+	if i.ReasonContainsFold != nil {
+		predicates = append(predicates, deadletter.ReasonContainsFold(*i.ReasonContainsFold))
+	}
+	if i.PayloadKeyID != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDEQ(*i.PayloadKeyID))
+	}
+	if i.PayloadKeyIDNEQ != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDNEQ(*i.PayloadKeyIDNEQ))
+	}
+	if len(i.PayloadKeyIDIn) > 0 {
+		predicates = append(predicates, deadletter.PayloadKeyIDIn(i.PayloadKeyIDIn...))
+	}
+	if len(i.PayloadKeyIDNotIn) > 0 {
+		predicates = append(predicates, deadletter.PayloadKeyIDNotIn(i.PayloadKeyIDNotIn...))
+	}
+	if i.PayloadKeyIDGT != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDGT(*i.PayloadKeyIDGT))
+	}
+	if i.PayloadKeyIDGTE != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDGTE(*i.PayloadKeyIDGTE))
+	}
+	if i.PayloadKeyIDLT != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDLT(*i.PayloadKeyIDLT))
+	}
+	if i.PayloadKeyIDLTE != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDLTE(*i.PayloadKeyIDLTE))
+	}
+	if i.PayloadKeyIDContains != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDContains(*i.PayloadKeyIDContains))
+	}
+	if i.PayloadKeyIDHasPrefix != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDHasPrefix(*i.PayloadKeyIDHasPrefix))
+	}
+	if i.PayloadKeyIDHasSuffix != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDHasSuffix(*i.PayloadKeyIDHasSuffix))
+	}
+	if i.PayloadKeyIDEqualFold != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDEqualFold(*i.PayloadKeyIDEqualFold))
+	}
+	if i.PayloadKeyIDContainsFold != nil {
+		predicates = append(predicates, deadletter.PayloadKeyIDContainsFold(*i.PayloadKeyIDContainsFold))
+	}
+	if i.Attempts != nil {
+		predicates = append(predicates, deadletter.AttemptsEQ(*i.Attempts))
+	}
+	if i.AttemptsNEQ != nil {
+		predicates = append(predicates, deadletter.AttemptsNEQ(*i.AttemptsNEQ))
+	}
+	if len(i.AttemptsIn) > 0 {
+		predicates = append(predicates, deadletter.AttemptsIn(i.AttemptsIn...))
+	}
+	if len(i.AttemptsNotIn) > 0 {
+		predicates = append(predicates, deadletter.AttemptsNotIn(i.AttemptsNotIn...))
+	}
+	if i.AttemptsGT != nil {
+		predicates = append(predicates, deadletter.AttemptsGT(*i.AttemptsGT))
+	}
+	if i.AttemptsGTE != nil {
+		predicates = append(predicates, deadletter.AttemptsGTE(*i.AttemptsGTE))
+	}
+	if i.AttemptsLT != nil {
+		predicates = append(predicates, deadletter.AttemptsLT(*i.AttemptsLT))
+	}
+	if i.AttemptsLTE != nil {
+		predicates = append(predicates, deadletter.AttemptsLTE(*i.AttemptsLTE))
+	}
+	if i.ReceivedAt != nil {
+		predicates = append(predicates, deadletter.ReceivedAtEQ(*i.ReceivedAt))
+	}
+	if i.ReceivedAtNEQ != nil {
+		predicates = append(predicates, deadletter.ReceivedAtNEQ(*i.ReceivedAtNEQ))
+	}
+	if len(i.ReceivedAtIn) > 0 {
+		predicates = append(predicates, deadletter.ReceivedAtIn(i.ReceivedAtIn...))
+	}
+	if len(i.ReceivedAtNotIn) > 0 {
+		predicates = append(predicates, deadletter.ReceivedAtNotIn(i.ReceivedAtNotIn...))
+	}
+	if i.ReceivedAtGT != nil {
+		predicates = append(predicates, deadletter.ReceivedAtGT(*i.ReceivedAtGT))
+	}
+	if i.ReceivedAtGTE != nil {
+		predicates = append(predicates, deadletter.ReceivedAtGTE(*i.ReceivedAtGTE))
+	}
+	if i.ReceivedAtLT != nil {
+		predicates = append(predicates, deadletter.ReceivedAtLT(*i.ReceivedAtLT))
+	}
+	if i.ReceivedAtLTE != nil {
+		predicates = append(predicates, deadletter.ReceivedAtLTE(*i.ReceivedAtLTE))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyDeadLetterWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return deadletter.And(predicates...), nil
+	}
+}
+
 // ResourceKindWhereInput represents a where input for filtering ResourceKind queries.
 type ResourceKindWhereInput struct {
 	Predicates []predicate.ResourceKind  `json:"-"`
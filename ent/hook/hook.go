@@ -9,6 +9,18 @@ import (
 	"github.com/strrl/kubernetes-auditing-dashboard/ent"
 )
 
+// The AuditAnnotationFunc type is an adapter to allow the use of ordinary
+// function as AuditAnnotation mutator.
+type AuditAnnotationFunc func(context.Context, *ent.AuditAnnotationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditAnnotationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditAnnotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditAnnotationMutation", m)
+}
+
 // The AuditEventFunc type is an adapter to allow the use of ordinary
 // function as AuditEvent mutator.
 type AuditEventFunc func(context.Context, *ent.AuditEventMutation) (ent.Value, error)
@@ -21,6 +33,18 @@ func (f AuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEventMutation", m)
 }
 
+// The DeadLetterFunc type is an adapter to allow the use of ordinary
+// function as DeadLetter mutator.
+type DeadLetterFunc func(context.Context, *ent.DeadLetterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeadLetterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeadLetterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeadLetterMutation", m)
+}
+
 // The ResourceKindFunc type is an adapter to allow the use of ordinary
 // function as ResourceKind mutator.
 type ResourceKindFunc func(context.Context, *ent.ResourceKindMutation) (ent.Value, error)
@@ -3,21 +3,50 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AuditAnnotationsColumns holds the columns for the "audit_annotations" table.
+	AuditAnnotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "audit_event_annotations", Type: field.TypeInt},
+	}
+	// AuditAnnotationsTable holds the schema information for the "audit_annotations" table.
+	AuditAnnotationsTable = &schema.Table{
+		Name:       "audit_annotations",
+		Columns:    AuditAnnotationsColumns,
+		PrimaryKey: []*schema.Column{AuditAnnotationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_annotations_audit_events_annotations",
+				Columns:    []*schema.Column{AuditAnnotationsColumns[3]},
+				RefColumns: []*schema.Column{AuditEventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditannotation_key",
+				Unique:  false,
+				Columns: []*schema.Column{AuditAnnotationsColumns[1]},
+			},
+		},
+	}
 	// AuditEventsColumns holds the columns for the "audit_events" table.
 	AuditEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "raw", Type: field.TypeString, Size: 2147483647},
+		{Name: "raw", Type: field.TypeString, Size: 2147483647, SchemaType: map[string]string{"mysql": "longblob", "postgres": "bytea"}},
 		{Name: "level", Type: field.TypeString},
 		{Name: "audit_id", Type: field.TypeString},
 		{Name: "verb", Type: field.TypeString},
 		{Name: "user_agent", Type: field.TypeString},
-		{Name: "request_timestamp", Type: field.TypeTime},
-		{Name: "stage_timestamp", Type: field.TypeTime},
+		{Name: "request_timestamp", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime(6)"}},
+		{Name: "stage_timestamp", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime(6)"}},
 		{Name: "namespace", Type: field.TypeString, Default: ""},
 		{Name: "name", Type: field.TypeString, Default: ""},
 		{Name: "api_version", Type: field.TypeString, Default: ""},
@@ -25,6 +54,16 @@ var (
 		{Name: "resource", Type: field.TypeString, Default: ""},
 		{Name: "sub_resource", Type: field.TypeString, Default: ""},
 		{Name: "stage", Type: field.TypeString},
+		{Name: "cluster", Type: field.TypeString, Default: "default"},
+		{Name: "sample_weight", Type: field.TypeInt, Default: 1},
+		{Name: "username", Type: field.TypeString, Default: ""},
+		{Name: "user_groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_ips", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_code", Type: field.TypeInt, Default: 0},
+		{Name: "impersonated_user", Type: field.TypeString, Default: ""},
+		{Name: "user_agent_name", Type: field.TypeString, Default: ""},
+		{Name: "raw_size", Type: field.TypeInt, Default: 0},
+		{Name: "raw_key_id", Type: field.TypeString, Default: ""},
 	}
 	// AuditEventsTable holds the schema information for the "audit_events" table.
 	AuditEventsTable = &schema.Table{
@@ -43,9 +82,9 @@ var (
 				Columns: []*schema.Column{AuditEventsColumns[4]},
 			},
 			{
-				Name:    "auditevent_audit_id",
-				Unique:  false,
-				Columns: []*schema.Column{AuditEventsColumns[3]},
+				Name:    "auditevent_audit_id_stage",
+				Unique:  true,
+				Columns: []*schema.Column{AuditEventsColumns[3], AuditEventsColumns[14]},
 			},
 			{
 				Name:    "auditevent_user_agent",
@@ -66,6 +105,72 @@ var (
 				Name:    "auditevent_api_group_api_version_resource_namespace_name_request_timestamp",
 				Unique:  false,
 				Columns: []*schema.Column{AuditEventsColumns[11], AuditEventsColumns[10], AuditEventsColumns[12], AuditEventsColumns[8], AuditEventsColumns[9], AuditEventsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					PrefixColumns: map[string]uint{
+						AuditEventsColumns[11].Name: 128,
+
+						AuditEventsColumns[10].Name: 64,
+
+						AuditEventsColumns[8].Name: 64,
+
+						AuditEventsColumns[12].Name: 64,
+					},
+				},
+			},
+			{
+				Name:    "auditevent_cluster_request_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[15], AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_username_request_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[17], AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_impersonated_user",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[21]},
+			},
+			{
+				Name:    "auditevent_response_code",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[20]},
+			},
+			{
+				Name:    "auditevent_user_agent_name",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[22]},
+			},
+		},
+	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "cluster", Type: field.TypeString, Default: "default"},
+		{Name: "remote_addr", Type: field.TypeString, Default: ""},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647, SchemaType: map[string]string{"mysql": "longblob", "postgres": "bytea"}},
+		{Name: "payload_key_id", Type: field.TypeString, Default: ""},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "received_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime(6)"}},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[8]},
+			},
+			{
+				Name:    "deadletter_kind_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[1], DeadLettersColumns[8]},
 			},
 		},
 	}
@@ -107,11 +212,14 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditAnnotationsTable,
 		AuditEventsTable,
+		DeadLettersTable,
 		ResourceKindsTable,
 		ViewsTable,
 	}
 )
 
 func init() {
+	AuditAnnotationsTable.ForeignKeys[0].RefTable = AuditEventsTable
 }
@@ -11,7 +11,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 )
@@ -25,48 +27,40 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuditEvent   = "AuditEvent"
-	TypeResourceKind = "ResourceKind"
-	TypeView         = "View"
+	TypeAuditAnnotation = "AuditAnnotation"
+	TypeAuditEvent      = "AuditEvent"
+	TypeDeadLetter      = "DeadLetter"
+	TypeResourceKind    = "ResourceKind"
+	TypeView            = "View"
 )
 
-// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
-type AuditEventMutation struct {
+// AuditAnnotationMutation represents an operation that mutates the AuditAnnotation nodes in the graph.
+type AuditAnnotationMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	raw              *string
-	level            *string
-	auditID          *string
-	verb             *string
-	userAgent        *string
-	requestTimestamp *time.Time
-	stageTimestamp   *time.Time
-	namespace        *string
-	name             *string
-	apiVersion       *string
-	apiGroup         *string
-	resource         *string
-	subResource      *string
-	stage            *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*AuditEvent, error)
-	predicates       []predicate.AuditEvent
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	event         *int
+	clearedevent  bool
+	done          bool
+	oldValue      func(context.Context) (*AuditAnnotation, error)
+	predicates    []predicate.AuditAnnotation
 }
 
-var _ ent.Mutation = (*AuditEventMutation)(nil)
+var _ ent.Mutation = (*AuditAnnotationMutation)(nil)
 
-// auditeventOption allows management of the mutation configuration using functional options.
-type auditeventOption func(*AuditEventMutation)
+// auditannotationOption allows management of the mutation configuration using functional options.
+type auditannotationOption func(*AuditAnnotationMutation)
 
-// newAuditEventMutation creates new mutation for the AuditEvent entity.
-func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
-	m := &AuditEventMutation{
+// newAuditAnnotationMutation creates new mutation for the AuditAnnotation entity.
+func newAuditAnnotationMutation(c config, op Op, opts ...auditannotationOption) *AuditAnnotationMutation {
+	m := &AuditAnnotationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuditEvent,
+		typ:           TypeAuditAnnotation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +69,20 @@ func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEven
 	return m
 }
 
-// withAuditEventID sets the ID field of the mutation.
-func withAuditEventID(id int) auditeventOption {
-	return func(m *AuditEventMutation) {
+// withAuditAnnotationID sets the ID field of the mutation.
+func withAuditAnnotationID(id int) auditannotationOption {
+	return func(m *AuditAnnotationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuditEvent
+			value *AuditAnnotation
 		)
-		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
+		m.oldValue = func(ctx context.Context) (*AuditAnnotation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuditEvent.Get(ctx, id)
+					value, err = m.Client().AuditAnnotation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +91,10 @@ func withAuditEventID(id int) auditeventOption {
 	}
 }
 
-// withAuditEvent sets the old AuditEvent of the mutation.
-func withAuditEvent(node *AuditEvent) auditeventOption {
-	return func(m *AuditEventMutation) {
-		m.oldValue = func(context.Context) (*AuditEvent, error) {
+// withAuditAnnotation sets the old AuditAnnotation of the mutation.
+func withAuditAnnotation(node *AuditAnnotation) auditannotationOption {
+	return func(m *AuditAnnotationMutation) {
+		m.oldValue = func(context.Context) (*AuditAnnotation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +103,7 @@ func withAuditEvent(node *AuditEvent) auditeventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuditEventMutation) Client() *Client {
+func (m AuditAnnotationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +111,7 @@ func (m AuditEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuditEventMutation) Tx() (*Tx, error) {
+func (m AuditAnnotationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -128,7 +122,7 @@ func (m AuditEventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuditEventMutation) ID() (id int, exists bool) {
+func (m *AuditAnnotationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -139,7 +133,7 @@ func (m *AuditEventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditAnnotationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -148,525 +142,2619 @@ func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditAnnotation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRaw sets the "raw" field.
-func (m *AuditEventMutation) SetRaw(s string) {
-	m.raw = &s
+// SetKey sets the "key" field.
+func (m *AuditAnnotationMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Raw returns the value of the "raw" field in the mutation.
-func (m *AuditEventMutation) Raw() (r string, exists bool) {
-	v := m.raw
+// Key returns the value of the "key" field in the mutation.
+func (m *AuditAnnotationMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRaw returns the old "raw" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the AuditAnnotation entity.
+// If the AuditAnnotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldRaw(ctx context.Context) (v string, err error) {
+func (m *AuditAnnotationMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRaw is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRaw requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRaw: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Raw, nil
+	return oldValue.Key, nil
 }
 
-// ResetRaw resets all changes to the "raw" field.
-func (m *AuditEventMutation) ResetRaw() {
-	m.raw = nil
+// ResetKey resets all changes to the "key" field.
+func (m *AuditAnnotationMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetLevel sets the "level" field.
-func (m *AuditEventMutation) SetLevel(s string) {
-	m.level = &s
+// SetValue sets the "value" field.
+func (m *AuditAnnotationMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *AuditEventMutation) Level() (r string, exists bool) {
-	v := m.level
+// Value returns the value of the "value" field in the mutation.
+func (m *AuditAnnotationMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the AuditAnnotation entity.
+// If the AuditAnnotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldLevel(ctx context.Context) (v string, err error) {
+func (m *AuditAnnotationMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Level, nil
+	return oldValue.Value, nil
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *AuditEventMutation) ResetLevel() {
-	m.level = nil
+// ResetValue resets all changes to the "value" field.
+func (m *AuditAnnotationMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetAuditID sets the "auditID" field.
-func (m *AuditEventMutation) SetAuditID(s string) {
-	m.auditID = &s
+// SetEventID sets the "event" edge to the AuditEvent entity by id.
+func (m *AuditAnnotationMutation) SetEventID(id int) {
+	m.event = &id
 }
 
-// AuditID returns the value of the "auditID" field in the mutation.
-func (m *AuditEventMutation) AuditID() (r string, exists bool) {
-	v := m.auditID
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEvent clears the "event" edge to the AuditEvent entity.
+func (m *AuditAnnotationMutation) ClearEvent() {
+	m.clearedevent = true
 }
 
-// OldAuditID returns the old "auditID" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldAuditID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuditID requires an ID field in the mutation")
+// EventCleared reports if the "event" edge to the AuditEvent entity was cleared.
+func (m *AuditAnnotationMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventID returns the "event" edge ID in the mutation.
+func (m *AuditAnnotationMutation) EventID() (id int, exists bool) {
+	if m.event != nil {
+		return *m.event, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
+	return
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *AuditAnnotationMutation) EventIDs() (ids []int) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.AuditID, nil
+	return
 }
 
-// ResetAuditID resets all changes to the "auditID" field.
-func (m *AuditEventMutation) ResetAuditID() {
-	m.auditID = nil
+// ResetEvent resets all changes to the "event" edge.
+func (m *AuditAnnotationMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
 }
 
-// SetVerb sets the "verb" field.
-func (m *AuditEventMutation) SetVerb(s string) {
-	m.verb = &s
+// Where appends a list predicates to the AuditAnnotationMutation builder.
+func (m *AuditAnnotationMutation) Where(ps ...predicate.AuditAnnotation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Verb returns the value of the "verb" field in the mutation.
-func (m *AuditEventMutation) Verb() (r string, exists bool) {
-	v := m.verb
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditAnnotationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditAnnotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditAnnotation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldVerb returns the old "verb" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldVerb(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerb is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerb requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerb: %w", err)
-	}
-	return oldValue.Verb, nil
+// Op returns the operation name.
+func (m *AuditAnnotationMutation) Op() Op {
+	return m.op
 }
 
-// ResetVerb resets all changes to the "verb" field.
-func (m *AuditEventMutation) ResetVerb() {
-	m.verb = nil
+// SetOp allows setting the mutation operation.
+func (m *AuditAnnotationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetUserAgent sets the "userAgent" field.
-func (m *AuditEventMutation) SetUserAgent(s string) {
-	m.userAgent = &s
+// Type returns the node type of this mutation (AuditAnnotation).
+func (m *AuditAnnotationMutation) Type() string {
+	return m.typ
 }
 
-// UserAgent returns the value of the "userAgent" field in the mutation.
-func (m *AuditEventMutation) UserAgent() (r string, exists bool) {
-	v := m.userAgent
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditAnnotationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, auditannotation.FieldKey)
 	}
-	return *v, true
+	if m.value != nil {
+		fields = append(fields, auditannotation.FieldValue)
+	}
+	return fields
 }
 
-// OldUserAgent returns the old "userAgent" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditAnnotationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditannotation.FieldKey:
+		return m.Key()
+	case auditannotation.FieldValue:
+		return m.Value()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditAnnotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditannotation.FieldKey:
+		return m.OldKey(ctx)
+	case auditannotation.FieldValue:
+		return m.OldValue(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	return nil, fmt.Errorf("unknown AuditAnnotation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditAnnotationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditannotation.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case auditannotation.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
 	}
-	return oldValue.UserAgent, nil
+	return fmt.Errorf("unknown AuditAnnotation field %s", name)
 }
 
-// ResetUserAgent resets all changes to the "userAgent" field.
-func (m *AuditEventMutation) ResetUserAgent() {
-	m.userAgent = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditAnnotationMutation) AddedFields() []string {
+	return nil
 }
 
-// SetRequestTimestamp sets the "requestTimestamp" field.
-func (m *AuditEventMutation) SetRequestTimestamp(t time.Time) {
-	m.requestTimestamp = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditAnnotationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// RequestTimestamp returns the value of the "requestTimestamp" field in the mutation.
-func (m *AuditEventMutation) RequestTimestamp() (r time.Time, exists bool) {
-	v := m.requestTimestamp
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditAnnotationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditAnnotation numeric field %s", name)
 }
 
-// OldRequestTimestamp returns the old "requestTimestamp" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldRequestTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestTimestamp: %w", err)
-	}
-	return oldValue.RequestTimestamp, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditAnnotationMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetRequestTimestamp resets all changes to the "requestTimestamp" field.
-func (m *AuditEventMutation) ResetRequestTimestamp() {
-	m.requestTimestamp = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditAnnotationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetStageTimestamp sets the "stageTimestamp" field.
-func (m *AuditEventMutation) SetStageTimestamp(t time.Time) {
-	m.stageTimestamp = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditAnnotationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuditAnnotation nullable field %s", name)
 }
 
-// StageTimestamp returns the value of the "stageTimestamp" field in the mutation.
-func (m *AuditEventMutation) StageTimestamp() (r time.Time, exists bool) {
-	v := m.stageTimestamp
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditAnnotationMutation) ResetField(name string) error {
+	switch name {
+	case auditannotation.FieldKey:
+		m.ResetKey()
+		return nil
+	case auditannotation.FieldValue:
+		m.ResetValue()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditAnnotation field %s", name)
 }
 
-// OldStageTimestamp returns the old "stageTimestamp" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldStageTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStageTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStageTimestamp requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditAnnotationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.event != nil {
+		edges = append(edges, auditannotation.EdgeEvent)
 	}
-	oldValue, err := m.oldValue(ctx)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditAnnotationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case auditannotation.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditAnnotationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditAnnotationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditAnnotationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedevent {
+		edges = append(edges, auditannotation.EdgeEvent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditAnnotationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case auditannotation.EdgeEvent:
+		return m.clearedevent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditAnnotationMutation) ClearEdge(name string) error {
+	switch name {
+	case auditannotation.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditAnnotation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditAnnotationMutation) ResetEdge(name string) error {
+	switch name {
+	case auditannotation.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditAnnotation edge %s", name)
+}
+
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	raw                *string
+	level              *string
+	auditID            *string
+	verb               *string
+	userAgent          *string
+	requestTimestamp   *time.Time
+	stageTimestamp     *time.Time
+	namespace          *string
+	name               *string
+	apiVersion         *string
+	apiGroup           *string
+	resource           *string
+	subResource        *string
+	stage              *string
+	cluster            *string
+	sampleWeight       *int
+	addsampleWeight    *int
+	username           *string
+	userGroups         *[]string
+	appenduserGroups   []string
+	sourceIPs          *[]string
+	appendsourceIPs    []string
+	responseCode       *int
+	addresponseCode    *int
+	impersonatedUser   *string
+	userAgentName      *string
+	rawSize            *int
+	addrawSize         *int
+	rawKeyID           *string
+	clearedFields      map[string]struct{}
+	annotations        map[int]struct{}
+	removedannotations map[int]struct{}
+	clearedannotations bool
+	done               bool
+	oldValue           func(context.Context) (*AuditEvent, error)
+	predicates         []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id int) auditeventOption {
+	return func(m *AuditEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditEvent
+		)
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRaw sets the "raw" field.
+func (m *AuditEventMutation) SetRaw(s string) {
+	m.raw = &s
+}
+
+// Raw returns the value of the "raw" field in the mutation.
+func (m *AuditEventMutation) Raw() (r string, exists bool) {
+	v := m.raw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRaw returns the old "raw" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldRaw(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRaw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRaw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRaw: %w", err)
+	}
+	return oldValue.Raw, nil
+}
+
+// ResetRaw resets all changes to the "raw" field.
+func (m *AuditEventMutation) ResetRaw() {
+	m.raw = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *AuditEventMutation) SetLevel(s string) {
+	m.level = &s
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *AuditEventMutation) Level() (r string, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *AuditEventMutation) ResetLevel() {
+	m.level = nil
+}
+
+// SetAuditID sets the "auditID" field.
+func (m *AuditEventMutation) SetAuditID(s string) {
+	m.auditID = &s
+}
+
+// AuditID returns the value of the "auditID" field in the mutation.
+func (m *AuditEventMutation) AuditID() (r string, exists bool) {
+	v := m.auditID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuditID returns the old "auditID" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAuditID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuditID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuditID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuditID: %w", err)
+	}
+	return oldValue.AuditID, nil
+}
+
+// ResetAuditID resets all changes to the "auditID" field.
+func (m *AuditEventMutation) ResetAuditID() {
+	m.auditID = nil
+}
+
+// SetVerb sets the "verb" field.
+func (m *AuditEventMutation) SetVerb(s string) {
+	m.verb = &s
+}
+
+// Verb returns the value of the "verb" field in the mutation.
+func (m *AuditEventMutation) Verb() (r string, exists bool) {
+	v := m.verb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerb returns the old "verb" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldVerb(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerb is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerb requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerb: %w", err)
+	}
+	return oldValue.Verb, nil
+}
+
+// ResetVerb resets all changes to the "verb" field.
+func (m *AuditEventMutation) ResetVerb() {
+	m.verb = nil
+}
+
+// SetUserAgent sets the "userAgent" field.
+func (m *AuditEventMutation) SetUserAgent(s string) {
+	m.userAgent = &s
+}
+
+// UserAgent returns the value of the "userAgent" field in the mutation.
+func (m *AuditEventMutation) UserAgent() (r string, exists bool) {
+	v := m.userAgent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "userAgent" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "userAgent" field.
+func (m *AuditEventMutation) ResetUserAgent() {
+	m.userAgent = nil
+}
+
+// SetRequestTimestamp sets the "requestTimestamp" field.
+func (m *AuditEventMutation) SetRequestTimestamp(t time.Time) {
+	m.requestTimestamp = &t
+}
+
+// RequestTimestamp returns the value of the "requestTimestamp" field in the mutation.
+func (m *AuditEventMutation) RequestTimestamp() (r time.Time, exists bool) {
+	v := m.requestTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestTimestamp returns the old "requestTimestamp" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldRequestTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestTimestamp: %w", err)
+	}
+	return oldValue.RequestTimestamp, nil
+}
+
+// ResetRequestTimestamp resets all changes to the "requestTimestamp" field.
+func (m *AuditEventMutation) ResetRequestTimestamp() {
+	m.requestTimestamp = nil
+}
+
+// SetStageTimestamp sets the "stageTimestamp" field.
+func (m *AuditEventMutation) SetStageTimestamp(t time.Time) {
+	m.stageTimestamp = &t
+}
+
+// StageTimestamp returns the value of the "stageTimestamp" field in the mutation.
+func (m *AuditEventMutation) StageTimestamp() (r time.Time, exists bool) {
+	v := m.stageTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStageTimestamp returns the old "stageTimestamp" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldStageTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStageTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStageTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStageTimestamp: %w", err)
+	}
+	return oldValue.StageTimestamp, nil
+}
+
+// ResetStageTimestamp resets all changes to the "stageTimestamp" field.
+func (m *AuditEventMutation) ResetStageTimestamp() {
+	m.stageTimestamp = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *AuditEventMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *AuditEventMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *AuditEventMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *AuditEventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AuditEventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *AuditEventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetApiVersion sets the "apiVersion" field.
+func (m *AuditEventMutation) SetApiVersion(s string) {
+	m.apiVersion = &s
+}
+
+// ApiVersion returns the value of the "apiVersion" field in the mutation.
+func (m *AuditEventMutation) ApiVersion() (r string, exists bool) {
+	v := m.apiVersion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApiVersion returns the old "apiVersion" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldApiVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApiVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApiVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApiVersion: %w", err)
+	}
+	return oldValue.ApiVersion, nil
+}
+
+// ResetApiVersion resets all changes to the "apiVersion" field.
+func (m *AuditEventMutation) ResetApiVersion() {
+	m.apiVersion = nil
+}
+
+// SetApiGroup sets the "apiGroup" field.
+func (m *AuditEventMutation) SetApiGroup(s string) {
+	m.apiGroup = &s
+}
+
+// ApiGroup returns the value of the "apiGroup" field in the mutation.
+func (m *AuditEventMutation) ApiGroup() (r string, exists bool) {
+	v := m.apiGroup
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApiGroup returns the old "apiGroup" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldApiGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApiGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApiGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApiGroup: %w", err)
+	}
+	return oldValue.ApiGroup, nil
+}
+
+// ResetApiGroup resets all changes to the "apiGroup" field.
+func (m *AuditEventMutation) ResetApiGroup() {
+	m.apiGroup = nil
+}
+
+// SetResource sets the "resource" field.
+func (m *AuditEventMutation) SetResource(s string) {
+	m.resource = &s
+}
+
+// Resource returns the value of the "resource" field in the mutation.
+func (m *AuditEventMutation) Resource() (r string, exists bool) {
+	v := m.resource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResource returns the old "resource" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+	}
+	return oldValue.Resource, nil
+}
+
+// ResetResource resets all changes to the "resource" field.
+func (m *AuditEventMutation) ResetResource() {
+	m.resource = nil
+}
+
+// SetSubResource sets the "subResource" field.
+func (m *AuditEventMutation) SetSubResource(s string) {
+	m.subResource = &s
+}
+
+// SubResource returns the value of the "subResource" field in the mutation.
+func (m *AuditEventMutation) SubResource() (r string, exists bool) {
+	v := m.subResource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubResource returns the old "subResource" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSubResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubResource: %w", err)
+	}
+	return oldValue.SubResource, nil
+}
+
+// ResetSubResource resets all changes to the "subResource" field.
+func (m *AuditEventMutation) ResetSubResource() {
+	m.subResource = nil
+}
+
+// SetStage sets the "stage" field.
+func (m *AuditEventMutation) SetStage(s string) {
+	m.stage = &s
+}
+
+// Stage returns the value of the "stage" field in the mutation.
+func (m *AuditEventMutation) Stage() (r string, exists bool) {
+	v := m.stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStage returns the old "stage" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldStage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+	}
+	return oldValue.Stage, nil
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *AuditEventMutation) ResetStage() {
+	m.stage = nil
+}
+
+// SetCluster sets the "cluster" field.
+func (m *AuditEventMutation) SetCluster(s string) {
+	m.cluster = &s
+}
+
+// Cluster returns the value of the "cluster" field in the mutation.
+func (m *AuditEventMutation) Cluster() (r string, exists bool) {
+	v := m.cluster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCluster returns the old "cluster" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldCluster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCluster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCluster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCluster: %w", err)
+	}
+	return oldValue.Cluster, nil
+}
+
+// ResetCluster resets all changes to the "cluster" field.
+func (m *AuditEventMutation) ResetCluster() {
+	m.cluster = nil
+}
+
+// SetSampleWeight sets the "sampleWeight" field.
+func (m *AuditEventMutation) SetSampleWeight(i int) {
+	m.sampleWeight = &i
+	m.addsampleWeight = nil
+}
+
+// SampleWeight returns the value of the "sampleWeight" field in the mutation.
+func (m *AuditEventMutation) SampleWeight() (r int, exists bool) {
+	v := m.sampleWeight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSampleWeight returns the old "sampleWeight" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSampleWeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSampleWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSampleWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSampleWeight: %w", err)
+	}
+	return oldValue.SampleWeight, nil
+}
+
+// AddSampleWeight adds i to the "sampleWeight" field.
+func (m *AuditEventMutation) AddSampleWeight(i int) {
+	if m.addsampleWeight != nil {
+		*m.addsampleWeight += i
+	} else {
+		m.addsampleWeight = &i
+	}
+}
+
+// AddedSampleWeight returns the value that was added to the "sampleWeight" field in this mutation.
+func (m *AuditEventMutation) AddedSampleWeight() (r int, exists bool) {
+	v := m.addsampleWeight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSampleWeight resets all changes to the "sampleWeight" field.
+func (m *AuditEventMutation) ResetSampleWeight() {
+	m.sampleWeight = nil
+	m.addsampleWeight = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *AuditEventMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *AuditEventMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *AuditEventMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetUserGroups sets the "userGroups" field.
+func (m *AuditEventMutation) SetUserGroups(s []string) {
+	m.userGroups = &s
+	m.appenduserGroups = nil
+}
+
+// UserGroups returns the value of the "userGroups" field in the mutation.
+func (m *AuditEventMutation) UserGroups() (r []string, exists bool) {
+	v := m.userGroups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserGroups returns the old "userGroups" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUserGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserGroups: %w", err)
+	}
+	return oldValue.UserGroups, nil
+}
+
+// AppendUserGroups adds s to the "userGroups" field.
+func (m *AuditEventMutation) AppendUserGroups(s []string) {
+	m.appenduserGroups = append(m.appenduserGroups, s...)
+}
+
+// AppendedUserGroups returns the list of values that were appended to the "userGroups" field in this mutation.
+func (m *AuditEventMutation) AppendedUserGroups() ([]string, bool) {
+	if len(m.appenduserGroups) == 0 {
+		return nil, false
+	}
+	return m.appenduserGroups, true
+}
+
+// ClearUserGroups clears the value of the "userGroups" field.
+func (m *AuditEventMutation) ClearUserGroups() {
+	m.userGroups = nil
+	m.appenduserGroups = nil
+	m.clearedFields[auditevent.FieldUserGroups] = struct{}{}
+}
+
+// UserGroupsCleared returns if the "userGroups" field was cleared in this mutation.
+func (m *AuditEventMutation) UserGroupsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldUserGroups]
+	return ok
+}
+
+// ResetUserGroups resets all changes to the "userGroups" field.
+func (m *AuditEventMutation) ResetUserGroups() {
+	m.userGroups = nil
+	m.appenduserGroups = nil
+	delete(m.clearedFields, auditevent.FieldUserGroups)
+}
+
+// SetSourceIPs sets the "sourceIPs" field.
+func (m *AuditEventMutation) SetSourceIPs(s []string) {
+	m.sourceIPs = &s
+	m.appendsourceIPs = nil
+}
+
+// SourceIPs returns the value of the "sourceIPs" field in the mutation.
+func (m *AuditEventMutation) SourceIPs() (r []string, exists bool) {
+	v := m.sourceIPs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceIPs returns the old "sourceIPs" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldSourceIPs(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceIPs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceIPs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceIPs: %w", err)
+	}
+	return oldValue.SourceIPs, nil
+}
+
+// AppendSourceIPs adds s to the "sourceIPs" field.
+func (m *AuditEventMutation) AppendSourceIPs(s []string) {
+	m.appendsourceIPs = append(m.appendsourceIPs, s...)
+}
+
+// AppendedSourceIPs returns the list of values that were appended to the "sourceIPs" field in this mutation.
+func (m *AuditEventMutation) AppendedSourceIPs() ([]string, bool) {
+	if len(m.appendsourceIPs) == 0 {
+		return nil, false
+	}
+	return m.appendsourceIPs, true
+}
+
+// ClearSourceIPs clears the value of the "sourceIPs" field.
+func (m *AuditEventMutation) ClearSourceIPs() {
+	m.sourceIPs = nil
+	m.appendsourceIPs = nil
+	m.clearedFields[auditevent.FieldSourceIPs] = struct{}{}
+}
+
+// SourceIPsCleared returns if the "sourceIPs" field was cleared in this mutation.
+func (m *AuditEventMutation) SourceIPsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldSourceIPs]
+	return ok
+}
+
+// ResetSourceIPs resets all changes to the "sourceIPs" field.
+func (m *AuditEventMutation) ResetSourceIPs() {
+	m.sourceIPs = nil
+	m.appendsourceIPs = nil
+	delete(m.clearedFields, auditevent.FieldSourceIPs)
+}
+
+// SetResponseCode sets the "responseCode" field.
+func (m *AuditEventMutation) SetResponseCode(i int) {
+	m.responseCode = &i
+	m.addresponseCode = nil
+}
+
+// ResponseCode returns the value of the "responseCode" field in the mutation.
+func (m *AuditEventMutation) ResponseCode() (r int, exists bool) {
+	v := m.responseCode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseCode returns the old "responseCode" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldResponseCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseCode: %w", err)
+	}
+	return oldValue.ResponseCode, nil
+}
+
+// AddResponseCode adds i to the "responseCode" field.
+func (m *AuditEventMutation) AddResponseCode(i int) {
+	if m.addresponseCode != nil {
+		*m.addresponseCode += i
+	} else {
+		m.addresponseCode = &i
+	}
+}
+
+// AddedResponseCode returns the value that was added to the "responseCode" field in this mutation.
+func (m *AuditEventMutation) AddedResponseCode() (r int, exists bool) {
+	v := m.addresponseCode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResponseCode resets all changes to the "responseCode" field.
+func (m *AuditEventMutation) ResetResponseCode() {
+	m.responseCode = nil
+	m.addresponseCode = nil
+}
+
+// SetImpersonatedUser sets the "impersonatedUser" field.
+func (m *AuditEventMutation) SetImpersonatedUser(s string) {
+	m.impersonatedUser = &s
+}
+
+// ImpersonatedUser returns the value of the "impersonatedUser" field in the mutation.
+func (m *AuditEventMutation) ImpersonatedUser() (r string, exists bool) {
+	v := m.impersonatedUser
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImpersonatedUser returns the old "impersonatedUser" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldImpersonatedUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpersonatedUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpersonatedUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpersonatedUser: %w", err)
+	}
+	return oldValue.ImpersonatedUser, nil
+}
+
+// ResetImpersonatedUser resets all changes to the "impersonatedUser" field.
+func (m *AuditEventMutation) ResetImpersonatedUser() {
+	m.impersonatedUser = nil
+}
+
+// SetUserAgentName sets the "userAgentName" field.
+func (m *AuditEventMutation) SetUserAgentName(s string) {
+	m.userAgentName = &s
+}
+
+// UserAgentName returns the value of the "userAgentName" field in the mutation.
+func (m *AuditEventMutation) UserAgentName() (r string, exists bool) {
+	v := m.userAgentName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgentName returns the old "userAgentName" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldUserAgentName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgentName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgentName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgentName: %w", err)
+	}
+	return oldValue.UserAgentName, nil
+}
+
+// ResetUserAgentName resets all changes to the "userAgentName" field.
+func (m *AuditEventMutation) ResetUserAgentName() {
+	m.userAgentName = nil
+}
+
+// SetRawSize sets the "rawSize" field.
+func (m *AuditEventMutation) SetRawSize(i int) {
+	m.rawSize = &i
+	m.addrawSize = nil
+}
+
+// RawSize returns the value of the "rawSize" field in the mutation.
+func (m *AuditEventMutation) RawSize() (r int, exists bool) {
+	v := m.rawSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawSize returns the old "rawSize" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldRawSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawSize: %w", err)
+	}
+	return oldValue.RawSize, nil
+}
+
+// AddRawSize adds i to the "rawSize" field.
+func (m *AuditEventMutation) AddRawSize(i int) {
+	if m.addrawSize != nil {
+		*m.addrawSize += i
+	} else {
+		m.addrawSize = &i
+	}
+}
+
+// AddedRawSize returns the value that was added to the "rawSize" field in this mutation.
+func (m *AuditEventMutation) AddedRawSize() (r int, exists bool) {
+	v := m.addrawSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRawSize resets all changes to the "rawSize" field.
+func (m *AuditEventMutation) ResetRawSize() {
+	m.rawSize = nil
+	m.addrawSize = nil
+}
+
+// SetRawKeyID sets the "rawKeyID" field.
+func (m *AuditEventMutation) SetRawKeyID(s string) {
+	m.rawKeyID = &s
+}
+
+// RawKeyID returns the value of the "rawKeyID" field in the mutation.
+func (m *AuditEventMutation) RawKeyID() (r string, exists bool) {
+	v := m.rawKeyID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawKeyID returns the old "rawKeyID" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldRawKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawKeyID: %w", err)
+	}
+	return oldValue.RawKeyID, nil
+}
+
+// ResetRawKeyID resets all changes to the "rawKeyID" field.
+func (m *AuditEventMutation) ResetRawKeyID() {
+	m.rawKeyID = nil
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the AuditAnnotation entity by ids.
+func (m *AuditEventMutation) AddAnnotationIDs(ids ...int) {
+	if m.annotations == nil {
+		m.annotations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.annotations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnnotations clears the "annotations" edge to the AuditAnnotation entity.
+func (m *AuditEventMutation) ClearAnnotations() {
+	m.clearedannotations = true
+}
+
+// AnnotationsCleared reports if the "annotations" edge to the AuditAnnotation entity was cleared.
+func (m *AuditEventMutation) AnnotationsCleared() bool {
+	return m.clearedannotations
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to the AuditAnnotation entity by IDs.
+func (m *AuditEventMutation) RemoveAnnotationIDs(ids ...int) {
+	if m.removedannotations == nil {
+		m.removedannotations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.annotations, ids[i])
+		m.removedannotations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnnotations returns the removed IDs of the "annotations" edge to the AuditAnnotation entity.
+func (m *AuditEventMutation) RemovedAnnotationsIDs() (ids []int) {
+	for id := range m.removedannotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
+func (m *AuditEventMutation) AnnotationsIDs() (ids []int) {
+	for id := range m.annotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnnotations resets all changes to the "annotations" edge.
+func (m *AuditEventMutation) ResetAnnotations() {
+	m.annotations = nil
+	m.clearedannotations = false
+	m.removedannotations = nil
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.raw != nil {
+		fields = append(fields, auditevent.FieldRaw)
+	}
+	if m.level != nil {
+		fields = append(fields, auditevent.FieldLevel)
+	}
+	if m.auditID != nil {
+		fields = append(fields, auditevent.FieldAuditID)
+	}
+	if m.verb != nil {
+		fields = append(fields, auditevent.FieldVerb)
+	}
+	if m.userAgent != nil {
+		fields = append(fields, auditevent.FieldUserAgent)
+	}
+	if m.requestTimestamp != nil {
+		fields = append(fields, auditevent.FieldRequestTimestamp)
+	}
+	if m.stageTimestamp != nil {
+		fields = append(fields, auditevent.FieldStageTimestamp)
+	}
+	if m.namespace != nil {
+		fields = append(fields, auditevent.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, auditevent.FieldName)
+	}
+	if m.apiVersion != nil {
+		fields = append(fields, auditevent.FieldApiVersion)
+	}
+	if m.apiGroup != nil {
+		fields = append(fields, auditevent.FieldApiGroup)
+	}
+	if m.resource != nil {
+		fields = append(fields, auditevent.FieldResource)
+	}
+	if m.subResource != nil {
+		fields = append(fields, auditevent.FieldSubResource)
+	}
+	if m.stage != nil {
+		fields = append(fields, auditevent.FieldStage)
+	}
+	if m.cluster != nil {
+		fields = append(fields, auditevent.FieldCluster)
+	}
+	if m.sampleWeight != nil {
+		fields = append(fields, auditevent.FieldSampleWeight)
+	}
+	if m.username != nil {
+		fields = append(fields, auditevent.FieldUsername)
+	}
+	if m.userGroups != nil {
+		fields = append(fields, auditevent.FieldUserGroups)
+	}
+	if m.sourceIPs != nil {
+		fields = append(fields, auditevent.FieldSourceIPs)
+	}
+	if m.responseCode != nil {
+		fields = append(fields, auditevent.FieldResponseCode)
+	}
+	if m.impersonatedUser != nil {
+		fields = append(fields, auditevent.FieldImpersonatedUser)
+	}
+	if m.userAgentName != nil {
+		fields = append(fields, auditevent.FieldUserAgentName)
+	}
+	if m.rawSize != nil {
+		fields = append(fields, auditevent.FieldRawSize)
+	}
+	if m.rawKeyID != nil {
+		fields = append(fields, auditevent.FieldRawKeyID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldRaw:
+		return m.Raw()
+	case auditevent.FieldLevel:
+		return m.Level()
+	case auditevent.FieldAuditID:
+		return m.AuditID()
+	case auditevent.FieldVerb:
+		return m.Verb()
+	case auditevent.FieldUserAgent:
+		return m.UserAgent()
+	case auditevent.FieldRequestTimestamp:
+		return m.RequestTimestamp()
+	case auditevent.FieldStageTimestamp:
+		return m.StageTimestamp()
+	case auditevent.FieldNamespace:
+		return m.Namespace()
+	case auditevent.FieldName:
+		return m.Name()
+	case auditevent.FieldApiVersion:
+		return m.ApiVersion()
+	case auditevent.FieldApiGroup:
+		return m.ApiGroup()
+	case auditevent.FieldResource:
+		return m.Resource()
+	case auditevent.FieldSubResource:
+		return m.SubResource()
+	case auditevent.FieldStage:
+		return m.Stage()
+	case auditevent.FieldCluster:
+		return m.Cluster()
+	case auditevent.FieldSampleWeight:
+		return m.SampleWeight()
+	case auditevent.FieldUsername:
+		return m.Username()
+	case auditevent.FieldUserGroups:
+		return m.UserGroups()
+	case auditevent.FieldSourceIPs:
+		return m.SourceIPs()
+	case auditevent.FieldResponseCode:
+		return m.ResponseCode()
+	case auditevent.FieldImpersonatedUser:
+		return m.ImpersonatedUser()
+	case auditevent.FieldUserAgentName:
+		return m.UserAgentName()
+	case auditevent.FieldRawSize:
+		return m.RawSize()
+	case auditevent.FieldRawKeyID:
+		return m.RawKeyID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldRaw:
+		return m.OldRaw(ctx)
+	case auditevent.FieldLevel:
+		return m.OldLevel(ctx)
+	case auditevent.FieldAuditID:
+		return m.OldAuditID(ctx)
+	case auditevent.FieldVerb:
+		return m.OldVerb(ctx)
+	case auditevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case auditevent.FieldRequestTimestamp:
+		return m.OldRequestTimestamp(ctx)
+	case auditevent.FieldStageTimestamp:
+		return m.OldStageTimestamp(ctx)
+	case auditevent.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case auditevent.FieldName:
+		return m.OldName(ctx)
+	case auditevent.FieldApiVersion:
+		return m.OldApiVersion(ctx)
+	case auditevent.FieldApiGroup:
+		return m.OldApiGroup(ctx)
+	case auditevent.FieldResource:
+		return m.OldResource(ctx)
+	case auditevent.FieldSubResource:
+		return m.OldSubResource(ctx)
+	case auditevent.FieldStage:
+		return m.OldStage(ctx)
+	case auditevent.FieldCluster:
+		return m.OldCluster(ctx)
+	case auditevent.FieldSampleWeight:
+		return m.OldSampleWeight(ctx)
+	case auditevent.FieldUsername:
+		return m.OldUsername(ctx)
+	case auditevent.FieldUserGroups:
+		return m.OldUserGroups(ctx)
+	case auditevent.FieldSourceIPs:
+		return m.OldSourceIPs(ctx)
+	case auditevent.FieldResponseCode:
+		return m.OldResponseCode(ctx)
+	case auditevent.FieldImpersonatedUser:
+		return m.OldImpersonatedUser(ctx)
+	case auditevent.FieldUserAgentName:
+		return m.OldUserAgentName(ctx)
+	case auditevent.FieldRawSize:
+		return m.OldRawSize(ctx)
+	case auditevent.FieldRawKeyID:
+		return m.OldRawKeyID(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldRaw:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRaw(v)
+		return nil
+	case auditevent.FieldLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case auditevent.FieldAuditID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuditID(v)
+		return nil
+	case auditevent.FieldVerb:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerb(v)
+		return nil
+	case auditevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case auditevent.FieldRequestTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestTimestamp(v)
+		return nil
+	case auditevent.FieldStageTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStageTimestamp(v)
+		return nil
+	case auditevent.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case auditevent.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case auditevent.FieldApiVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApiVersion(v)
+		return nil
+	case auditevent.FieldApiGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApiGroup(v)
+		return nil
+	case auditevent.FieldResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResource(v)
+		return nil
+	case auditevent.FieldSubResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubResource(v)
+		return nil
+	case auditevent.FieldStage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case auditevent.FieldCluster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCluster(v)
+		return nil
+	case auditevent.FieldSampleWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSampleWeight(v)
+		return nil
+	case auditevent.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case auditevent.FieldUserGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserGroups(v)
+		return nil
+	case auditevent.FieldSourceIPs:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceIPs(v)
+		return nil
+	case auditevent.FieldResponseCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseCode(v)
+		return nil
+	case auditevent.FieldImpersonatedUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpersonatedUser(v)
+		return nil
+	case auditevent.FieldUserAgentName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgentName(v)
+		return nil
+	case auditevent.FieldRawSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawSize(v)
+		return nil
+	case auditevent.FieldRawKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawKeyID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsampleWeight != nil {
+		fields = append(fields, auditevent.FieldSampleWeight)
+	}
+	if m.addresponseCode != nil {
+		fields = append(fields, auditevent.FieldResponseCode)
+	}
+	if m.addrawSize != nil {
+		fields = append(fields, auditevent.FieldRawSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldSampleWeight:
+		return m.AddedSampleWeight()
+	case auditevent.FieldResponseCode:
+		return m.AddedResponseCode()
+	case auditevent.FieldRawSize:
+		return m.AddedRawSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditevent.FieldSampleWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSampleWeight(v)
+		return nil
+	case auditevent.FieldResponseCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseCode(v)
+		return nil
+	case auditevent.FieldRawSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRawSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditevent.FieldUserGroups) {
+		fields = append(fields, auditevent.FieldUserGroups)
+	}
+	if m.FieldCleared(auditevent.FieldSourceIPs) {
+		fields = append(fields, auditevent.FieldSourceIPs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditEventMutation) ClearField(name string) error {
+	switch name {
+	case auditevent.FieldUserGroups:
+		m.ClearUserGroups()
+		return nil
+	case auditevent.FieldSourceIPs:
+		m.ClearSourceIPs()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditEventMutation) ResetField(name string) error {
+	switch name {
+	case auditevent.FieldRaw:
+		m.ResetRaw()
+		return nil
+	case auditevent.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case auditevent.FieldAuditID:
+		m.ResetAuditID()
+		return nil
+	case auditevent.FieldVerb:
+		m.ResetVerb()
+		return nil
+	case auditevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case auditevent.FieldRequestTimestamp:
+		m.ResetRequestTimestamp()
+		return nil
+	case auditevent.FieldStageTimestamp:
+		m.ResetStageTimestamp()
+		return nil
+	case auditevent.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case auditevent.FieldName:
+		m.ResetName()
+		return nil
+	case auditevent.FieldApiVersion:
+		m.ResetApiVersion()
+		return nil
+	case auditevent.FieldApiGroup:
+		m.ResetApiGroup()
+		return nil
+	case auditevent.FieldResource:
+		m.ResetResource()
+		return nil
+	case auditevent.FieldSubResource:
+		m.ResetSubResource()
+		return nil
+	case auditevent.FieldStage:
+		m.ResetStage()
+		return nil
+	case auditevent.FieldCluster:
+		m.ResetCluster()
+		return nil
+	case auditevent.FieldSampleWeight:
+		m.ResetSampleWeight()
+		return nil
+	case auditevent.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case auditevent.FieldUserGroups:
+		m.ResetUserGroups()
+		return nil
+	case auditevent.FieldSourceIPs:
+		m.ResetSourceIPs()
+		return nil
+	case auditevent.FieldResponseCode:
+		m.ResetResponseCode()
+		return nil
+	case auditevent.FieldImpersonatedUser:
+		m.ResetImpersonatedUser()
+		return nil
+	case auditevent.FieldUserAgentName:
+		m.ResetUserAgentName()
+		return nil
+	case auditevent.FieldRawSize:
+		m.ResetRawSize()
+		return nil
+	case auditevent.FieldRawKeyID:
+		m.ResetRawKeyID()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.annotations != nil {
+		edges = append(edges, auditevent.EdgeAnnotations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case auditevent.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.annotations))
+		for id := range m.annotations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedannotations != nil {
+		edges = append(edges, auditevent.EdgeAnnotations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case auditevent.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.removedannotations))
+		for id := range m.removedannotations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedannotations {
+		edges = append(edges, auditevent.EdgeAnnotations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case auditevent.EdgeAnnotations:
+		return m.clearedannotations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	switch name {
+	case auditevent.EdgeAnnotations:
+		m.ResetAnnotations()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
+}
+
+// DeadLetterMutation represents an operation that mutates the DeadLetter nodes in the graph.
+type DeadLetterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	kind          *string
+	cluster       *string
+	remoteAddr    *string
+	reason        *string
+	payload       *string
+	payloadKeyID  *string
+	attempts      *int
+	addattempts   *int
+	receivedAt    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeadLetter, error)
+	predicates    []predicate.DeadLetter
+}
+
+var _ ent.Mutation = (*DeadLetterMutation)(nil)
+
+// deadletterOption allows management of the mutation configuration using functional options.
+type deadletterOption func(*DeadLetterMutation)
+
+// newDeadLetterMutation creates new mutation for the DeadLetter entity.
+func newDeadLetterMutation(c config, op Op, opts ...deadletterOption) *DeadLetterMutation {
+	m := &DeadLetterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeadLetter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeadLetterID sets the ID field of the mutation.
+func withDeadLetterID(id int) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeadLetter
+		)
+		m.oldValue = func(ctx context.Context) (*DeadLetter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeadLetter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeadLetter sets the old DeadLetter of the mutation.
+func withDeadLetter(node *DeadLetter) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		m.oldValue = func(context.Context) (*DeadLetter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeadLetterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeadLetterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeadLetterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeadLetterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeadLetter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *DeadLetterMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *DeadLetterMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStageTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.StageTimestamp, nil
+	return oldValue.Kind, nil
 }
 
-// ResetStageTimestamp resets all changes to the "stageTimestamp" field.
-func (m *AuditEventMutation) ResetStageTimestamp() {
-	m.stageTimestamp = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *DeadLetterMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetNamespace sets the "namespace" field.
-func (m *AuditEventMutation) SetNamespace(s string) {
-	m.namespace = &s
+// SetCluster sets the "cluster" field.
+func (m *DeadLetterMutation) SetCluster(s string) {
+	m.cluster = &s
 }
 
-// Namespace returns the value of the "namespace" field in the mutation.
-func (m *AuditEventMutation) Namespace() (r string, exists bool) {
-	v := m.namespace
+// Cluster returns the value of the "cluster" field in the mutation.
+func (m *DeadLetterMutation) Cluster() (r string, exists bool) {
+	v := m.cluster
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNamespace returns the old "namespace" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCluster returns the old "cluster" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldNamespace(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldCluster(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+		return v, errors.New("OldCluster is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNamespace requires an ID field in the mutation")
+		return v, errors.New("OldCluster requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+		return v, fmt.Errorf("querying old value for OldCluster: %w", err)
 	}
-	return oldValue.Namespace, nil
+	return oldValue.Cluster, nil
 }
 
-// ResetNamespace resets all changes to the "namespace" field.
-func (m *AuditEventMutation) ResetNamespace() {
-	m.namespace = nil
+// ResetCluster resets all changes to the "cluster" field.
+func (m *DeadLetterMutation) ResetCluster() {
+	m.cluster = nil
 }
 
-// SetName sets the "name" field.
-func (m *AuditEventMutation) SetName(s string) {
-	m.name = &s
+// SetRemoteAddr sets the "remoteAddr" field.
+func (m *DeadLetterMutation) SetRemoteAddr(s string) {
+	m.remoteAddr = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *AuditEventMutation) Name() (r string, exists bool) {
-	v := m.name
+// RemoteAddr returns the value of the "remoteAddr" field in the mutation.
+func (m *DeadLetterMutation) RemoteAddr() (r string, exists bool) {
+	v := m.remoteAddr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteAddr returns the old "remoteAddr" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldRemoteAddr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteAddr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRemoteAddr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteAddr: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.RemoteAddr, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *AuditEventMutation) ResetName() {
-	m.name = nil
+// ResetRemoteAddr resets all changes to the "remoteAddr" field.
+func (m *DeadLetterMutation) ResetRemoteAddr() {
+	m.remoteAddr = nil
 }
 
-// SetApiVersion sets the "apiVersion" field.
-func (m *AuditEventMutation) SetApiVersion(s string) {
-	m.apiVersion = &s
+// SetReason sets the "reason" field.
+func (m *DeadLetterMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// ApiVersion returns the value of the "apiVersion" field in the mutation.
-func (m *AuditEventMutation) ApiVersion() (r string, exists bool) {
-	v := m.apiVersion
+// Reason returns the value of the "reason" field in the mutation.
+func (m *DeadLetterMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApiVersion returns the old "apiVersion" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldApiVersion(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApiVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApiVersion requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApiVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.ApiVersion, nil
+	return oldValue.Reason, nil
 }
 
-// ResetApiVersion resets all changes to the "apiVersion" field.
-func (m *AuditEventMutation) ResetApiVersion() {
-	m.apiVersion = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *DeadLetterMutation) ResetReason() {
+	m.reason = nil
 }
 
-// SetApiGroup sets the "apiGroup" field.
-func (m *AuditEventMutation) SetApiGroup(s string) {
-	m.apiGroup = &s
+// SetPayload sets the "payload" field.
+func (m *DeadLetterMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ApiGroup returns the value of the "apiGroup" field in the mutation.
-func (m *AuditEventMutation) ApiGroup() (r string, exists bool) {
-	v := m.apiGroup
+// Payload returns the value of the "payload" field in the mutation.
+func (m *DeadLetterMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApiGroup returns the old "apiGroup" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldApiGroup(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApiGroup is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApiGroup requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApiGroup: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ApiGroup, nil
+	return oldValue.Payload, nil
 }
 
-// ResetApiGroup resets all changes to the "apiGroup" field.
-func (m *AuditEventMutation) ResetApiGroup() {
-	m.apiGroup = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *DeadLetterMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetResource sets the "resource" field.
-func (m *AuditEventMutation) SetResource(s string) {
-	m.resource = &s
+// SetPayloadKeyID sets the "payloadKeyID" field.
+func (m *DeadLetterMutation) SetPayloadKeyID(s string) {
+	m.payloadKeyID = &s
 }
 
-// Resource returns the value of the "resource" field in the mutation.
-func (m *AuditEventMutation) Resource() (r string, exists bool) {
-	v := m.resource
+// PayloadKeyID returns the value of the "payloadKeyID" field in the mutation.
+func (m *DeadLetterMutation) PayloadKeyID() (r string, exists bool) {
+	v := m.payloadKeyID
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResource returns the old "resource" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldPayloadKeyID returns the old "payloadKeyID" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldResource(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldPayloadKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayloadKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResource requires an ID field in the mutation")
+		return v, errors.New("OldPayloadKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayloadKeyID: %w", err)
 	}
-	return oldValue.Resource, nil
+	return oldValue.PayloadKeyID, nil
 }
 
-// ResetResource resets all changes to the "resource" field.
-func (m *AuditEventMutation) ResetResource() {
-	m.resource = nil
+// ResetPayloadKeyID resets all changes to the "payloadKeyID" field.
+func (m *DeadLetterMutation) ResetPayloadKeyID() {
+	m.payloadKeyID = nil
 }
 
-// SetSubResource sets the "subResource" field.
-func (m *AuditEventMutation) SetSubResource(s string) {
-	m.subResource = &s
+// SetAttempts sets the "attempts" field.
+func (m *DeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// SubResource returns the value of the "subResource" field in the mutation.
-func (m *AuditEventMutation) SubResource() (r string, exists bool) {
-	v := m.subResource
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *DeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubResource returns the old "subResource" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldSubResource(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubResource is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubResource requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubResource: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *DeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *DeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
 	}
-	return oldValue.SubResource, nil
+	return *v, true
 }
 
-// ResetSubResource resets all changes to the "subResource" field.
-func (m *AuditEventMutation) ResetSubResource() {
-	m.subResource = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *DeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetStage sets the "stage" field.
-func (m *AuditEventMutation) SetStage(s string) {
-	m.stage = &s
+// SetReceivedAt sets the "receivedAt" field.
+func (m *DeadLetterMutation) SetReceivedAt(t time.Time) {
+	m.receivedAt = &t
 }
 
-// Stage returns the value of the "stage" field in the mutation.
-func (m *AuditEventMutation) Stage() (r string, exists bool) {
-	v := m.stage
+// ReceivedAt returns the value of the "receivedAt" field in the mutation.
+func (m *DeadLetterMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.receivedAt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStage returns the old "stage" field's value of the AuditEvent entity.
-// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldReceivedAt returns the old "receivedAt" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditEventMutation) OldStage(ctx context.Context) (v string, err error) {
+func (m *DeadLetterMutation) OldReceivedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStage is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStage requires an ID field in the mutation")
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStage: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
 	}
-	return oldValue.Stage, nil
+	return oldValue.ReceivedAt, nil
 }
 
-// ResetStage resets all changes to the "stage" field.
-func (m *AuditEventMutation) ResetStage() {
-	m.stage = nil
+// ResetReceivedAt resets all changes to the "receivedAt" field.
+func (m *DeadLetterMutation) ResetReceivedAt() {
+	m.receivedAt = nil
 }
 
-// Where appends a list predicates to the AuditEventMutation builder.
-func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+// Where appends a list predicates to the DeadLetterMutation builder.
+func (m *DeadLetterMutation) Where(ps ...predicate.DeadLetter) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeadLetterMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuditEvent, len(ps))
+func (m *DeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeadLetter, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -674,66 +2762,48 @@ func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuditEventMutation) Op() Op {
+func (m *DeadLetterMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuditEventMutation) SetOp(op Op) {
+func (m *DeadLetterMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuditEvent).
-func (m *AuditEventMutation) Type() string {
+// Type returns the node type of this mutation (DeadLetter).
+func (m *DeadLetterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuditEventMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.raw != nil {
-		fields = append(fields, auditevent.FieldRaw)
-	}
-	if m.level != nil {
-		fields = append(fields, auditevent.FieldLevel)
-	}
-	if m.auditID != nil {
-		fields = append(fields, auditevent.FieldAuditID)
-	}
-	if m.verb != nil {
-		fields = append(fields, auditevent.FieldVerb)
-	}
-	if m.userAgent != nil {
-		fields = append(fields, auditevent.FieldUserAgent)
-	}
-	if m.requestTimestamp != nil {
-		fields = append(fields, auditevent.FieldRequestTimestamp)
-	}
-	if m.stageTimestamp != nil {
-		fields = append(fields, auditevent.FieldStageTimestamp)
+func (m *DeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.kind != nil {
+		fields = append(fields, deadletter.FieldKind)
 	}
-	if m.namespace != nil {
-		fields = append(fields, auditevent.FieldNamespace)
+	if m.cluster != nil {
+		fields = append(fields, deadletter.FieldCluster)
 	}
-	if m.name != nil {
-		fields = append(fields, auditevent.FieldName)
+	if m.remoteAddr != nil {
+		fields = append(fields, deadletter.FieldRemoteAddr)
 	}
-	if m.apiVersion != nil {
-		fields = append(fields, auditevent.FieldApiVersion)
+	if m.reason != nil {
+		fields = append(fields, deadletter.FieldReason)
 	}
-	if m.apiGroup != nil {
-		fields = append(fields, auditevent.FieldApiGroup)
+	if m.payload != nil {
+		fields = append(fields, deadletter.FieldPayload)
 	}
-	if m.resource != nil {
-		fields = append(fields, auditevent.FieldResource)
+	if m.payloadKeyID != nil {
+		fields = append(fields, deadletter.FieldPayloadKeyID)
 	}
-	if m.subResource != nil {
-		fields = append(fields, auditevent.FieldSubResource)
+	if m.attempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
 	}
-	if m.stage != nil {
-		fields = append(fields, auditevent.FieldStage)
+	if m.receivedAt != nil {
+		fields = append(fields, deadletter.FieldReceivedAt)
 	}
 	return fields
 }
@@ -741,36 +2811,24 @@ func (m *AuditEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+func (m *DeadLetterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case auditevent.FieldRaw:
-		return m.Raw()
-	case auditevent.FieldLevel:
-		return m.Level()
-	case auditevent.FieldAuditID:
-		return m.AuditID()
-	case auditevent.FieldVerb:
-		return m.Verb()
-	case auditevent.FieldUserAgent:
-		return m.UserAgent()
-	case auditevent.FieldRequestTimestamp:
-		return m.RequestTimestamp()
-	case auditevent.FieldStageTimestamp:
-		return m.StageTimestamp()
-	case auditevent.FieldNamespace:
-		return m.Namespace()
-	case auditevent.FieldName:
-		return m.Name()
-	case auditevent.FieldApiVersion:
-		return m.ApiVersion()
-	case auditevent.FieldApiGroup:
-		return m.ApiGroup()
-	case auditevent.FieldResource:
-		return m.Resource()
-	case auditevent.FieldSubResource:
-		return m.SubResource()
-	case auditevent.FieldStage:
-		return m.Stage()
+	case deadletter.FieldKind:
+		return m.Kind()
+	case deadletter.FieldCluster:
+		return m.Cluster()
+	case deadletter.FieldRemoteAddr:
+		return m.RemoteAddr()
+	case deadletter.FieldReason:
+		return m.Reason()
+	case deadletter.FieldPayload:
+		return m.Payload()
+	case deadletter.FieldPayloadKeyID:
+		return m.PayloadKeyID()
+	case deadletter.FieldAttempts:
+		return m.Attempts()
+	case deadletter.FieldReceivedAt:
+		return m.ReceivedAt()
 	}
 	return nil, false
 }
@@ -778,284 +2836,227 @@ func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case auditevent.FieldRaw:
-		return m.OldRaw(ctx)
-	case auditevent.FieldLevel:
-		return m.OldLevel(ctx)
-	case auditevent.FieldAuditID:
-		return m.OldAuditID(ctx)
-	case auditevent.FieldVerb:
-		return m.OldVerb(ctx)
-	case auditevent.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case auditevent.FieldRequestTimestamp:
-		return m.OldRequestTimestamp(ctx)
-	case auditevent.FieldStageTimestamp:
-		return m.OldStageTimestamp(ctx)
-	case auditevent.FieldNamespace:
-		return m.OldNamespace(ctx)
-	case auditevent.FieldName:
-		return m.OldName(ctx)
-	case auditevent.FieldApiVersion:
-		return m.OldApiVersion(ctx)
-	case auditevent.FieldApiGroup:
-		return m.OldApiGroup(ctx)
-	case auditevent.FieldResource:
-		return m.OldResource(ctx)
-	case auditevent.FieldSubResource:
-		return m.OldSubResource(ctx)
-	case auditevent.FieldStage:
-		return m.OldStage(ctx)
-	}
-	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
+	case deadletter.FieldKind:
+		return m.OldKind(ctx)
+	case deadletter.FieldCluster:
+		return m.OldCluster(ctx)
+	case deadletter.FieldRemoteAddr:
+		return m.OldRemoteAddr(ctx)
+	case deadletter.FieldReason:
+		return m.OldReason(ctx)
+	case deadletter.FieldPayload:
+		return m.OldPayload(ctx)
+	case deadletter.FieldPayloadKeyID:
+		return m.OldPayloadKeyID(ctx)
+	case deadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case deadletter.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
+func (m *DeadLetterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case auditevent.FieldRaw:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRaw(v)
-		return nil
-	case auditevent.FieldLevel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
-		return nil
-	case auditevent.FieldAuditID:
+	case deadletter.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuditID(v)
-		return nil
-	case auditevent.FieldVerb:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVerb(v)
-		return nil
-	case auditevent.FieldUserAgent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserAgent(v)
-		return nil
-	case auditevent.FieldRequestTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRequestTimestamp(v)
-		return nil
-	case auditevent.FieldStageTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStageTimestamp(v)
+		m.SetKind(v)
 		return nil
-	case auditevent.FieldNamespace:
+	case deadletter.FieldCluster:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNamespace(v)
+		m.SetCluster(v)
 		return nil
-	case auditevent.FieldName:
+	case deadletter.FieldRemoteAddr:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetRemoteAddr(v)
 		return nil
-	case auditevent.FieldApiVersion:
+	case deadletter.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApiVersion(v)
+		m.SetReason(v)
 		return nil
-	case auditevent.FieldApiGroup:
+	case deadletter.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApiGroup(v)
+		m.SetPayload(v)
 		return nil
-	case auditevent.FieldResource:
+	case deadletter.FieldPayloadKeyID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResource(v)
+		m.SetPayloadKeyID(v)
 		return nil
-	case auditevent.FieldSubResource:
-		v, ok := value.(string)
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubResource(v)
+		m.SetAttempts(v)
 		return nil
-	case auditevent.FieldStage:
-		v, ok := value.(string)
+	case deadletter.FieldReceivedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStage(v)
+		m.SetReceivedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditEvent field %s", name)
+	return fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuditEventMutation) AddedFields() []string {
-	return nil
+func (m *DeadLetterMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deadletter.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
+func (m *DeadLetterMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
+	return fmt.Errorf("unknown DeadLetter numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuditEventMutation) ClearedFields() []string {
+func (m *DeadLetterMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuditEventMutation) FieldCleared(name string) bool {
+func (m *DeadLetterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuditEventMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
+func (m *DeadLetterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DeadLetter nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuditEventMutation) ResetField(name string) error {
+func (m *DeadLetterMutation) ResetField(name string) error {
 	switch name {
-	case auditevent.FieldRaw:
-		m.ResetRaw()
-		return nil
-	case auditevent.FieldLevel:
-		m.ResetLevel()
-		return nil
-	case auditevent.FieldAuditID:
-		m.ResetAuditID()
-		return nil
-	case auditevent.FieldVerb:
-		m.ResetVerb()
-		return nil
-	case auditevent.FieldUserAgent:
-		m.ResetUserAgent()
-		return nil
-	case auditevent.FieldRequestTimestamp:
-		m.ResetRequestTimestamp()
-		return nil
-	case auditevent.FieldStageTimestamp:
-		m.ResetStageTimestamp()
+	case deadletter.FieldKind:
+		m.ResetKind()
 		return nil
-	case auditevent.FieldNamespace:
-		m.ResetNamespace()
+	case deadletter.FieldCluster:
+		m.ResetCluster()
 		return nil
-	case auditevent.FieldName:
-		m.ResetName()
+	case deadletter.FieldRemoteAddr:
+		m.ResetRemoteAddr()
 		return nil
-	case auditevent.FieldApiVersion:
-		m.ResetApiVersion()
+	case deadletter.FieldReason:
+		m.ResetReason()
 		return nil
-	case auditevent.FieldApiGroup:
-		m.ResetApiGroup()
+	case deadletter.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case auditevent.FieldResource:
-		m.ResetResource()
+	case deadletter.FieldPayloadKeyID:
+		m.ResetPayloadKeyID()
 		return nil
-	case auditevent.FieldSubResource:
-		m.ResetSubResource()
+	case deadletter.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case auditevent.FieldStage:
-		m.ResetStage()
+	case deadletter.FieldReceivedAt:
+		m.ResetReceivedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditEvent field %s", name)
+	return fmt.Errorf("unknown DeadLetter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuditEventMutation) AddedEdges() []string {
+func (m *DeadLetterMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
+func (m *DeadLetterMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuditEventMutation) RemovedEdges() []string {
+func (m *DeadLetterMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeadLetterMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuditEventMutation) ClearedEdges() []string {
+func (m *DeadLetterMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuditEventMutation) EdgeCleared(name string) bool {
+func (m *DeadLetterMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuditEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
+func (m *DeadLetterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuditEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuditEvent edge %s", name)
+func (m *DeadLetterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter edge %s", name)
 }
 
 // ResourceKindMutation represents an operation that mutates the ResourceKind nodes in the graph.
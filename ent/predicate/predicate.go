@@ -6,9 +6,37 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditAnnotation is the predicate function for auditannotation builders.
+type AuditAnnotation func(*sql.Selector)
+
 // AuditEvent is the predicate function for auditevent builders.
 type AuditEvent func(*sql.Selector)
 
+// AuditEventOrErr calls the predicate only if the error is not nit.
+func AuditEventOrErr(p AuditEvent, err error) AuditEvent {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
+// DeadLetterOrErr calls the predicate only if the error is not nit.
+func DeadLetterOrErr(p DeadLetter, err error) DeadLetter {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
 // ResourceKind is the predicate function for resourcekind builders.
 type ResourceKind func(*sql.Selector)
 
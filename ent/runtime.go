@@ -3,19 +3,36 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/resourcekind"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/schema"
+
+	"entgo.io/ent/schema/field"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditannotationFields := schema.AuditAnnotation{}.Fields()
+	_ = auditannotationFields
+	// auditannotationDescKey is the schema descriptor for key field.
+	auditannotationDescKey := auditannotationFields[0].Descriptor()
+	// auditannotation.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	auditannotation.KeyValidator = auditannotationDescKey.Validators[0].(func(string) error)
+	// auditannotationDescValue is the schema descriptor for value field.
+	auditannotationDescValue := auditannotationFields[1].Descriptor()
+	// auditannotation.DefaultValue holds the default value on creation for the value field.
+	auditannotation.DefaultValue = auditannotationDescValue.Default.(string)
 	auditeventFields := schema.AuditEvent{}.Fields()
 	_ = auditeventFields
 	// auditeventDescRaw is the schema descriptor for raw field.
 	auditeventDescRaw := auditeventFields[0].Descriptor()
+	auditevent.ValueScanner.Raw = auditeventDescRaw.ValueScanner.(field.TypeValueScanner[string])
 	// auditevent.RawValidator is a validator for the "raw" field. It is called by the builders before save.
 	auditevent.RawValidator = auditeventDescRaw.Validators[0].(func(string) error)
 	// auditeventDescLevel is the schema descriptor for level field.
@@ -58,6 +75,69 @@ func init() {
 	auditeventDescSubResource := auditeventFields[12].Descriptor()
 	// auditevent.DefaultSubResource holds the default value on creation for the subResource field.
 	auditevent.DefaultSubResource = auditeventDescSubResource.Default.(string)
+	// auditeventDescCluster is the schema descriptor for cluster field.
+	auditeventDescCluster := auditeventFields[14].Descriptor()
+	// auditevent.DefaultCluster holds the default value on creation for the cluster field.
+	auditevent.DefaultCluster = auditeventDescCluster.Default.(string)
+	// auditeventDescSampleWeight is the schema descriptor for sampleWeight field.
+	auditeventDescSampleWeight := auditeventFields[15].Descriptor()
+	// auditevent.DefaultSampleWeight holds the default value on creation for the sampleWeight field.
+	auditevent.DefaultSampleWeight = auditeventDescSampleWeight.Default.(int)
+	// auditeventDescUsername is the schema descriptor for username field.
+	auditeventDescUsername := auditeventFields[16].Descriptor()
+	// auditevent.DefaultUsername holds the default value on creation for the username field.
+	auditevent.DefaultUsername = auditeventDescUsername.Default.(string)
+	// auditeventDescResponseCode is the schema descriptor for responseCode field.
+	auditeventDescResponseCode := auditeventFields[19].Descriptor()
+	// auditevent.DefaultResponseCode holds the default value on creation for the responseCode field.
+	auditevent.DefaultResponseCode = auditeventDescResponseCode.Default.(int)
+	// auditeventDescImpersonatedUser is the schema descriptor for impersonatedUser field.
+	auditeventDescImpersonatedUser := auditeventFields[20].Descriptor()
+	// auditevent.DefaultImpersonatedUser holds the default value on creation for the impersonatedUser field.
+	auditevent.DefaultImpersonatedUser = auditeventDescImpersonatedUser.Default.(string)
+	// auditeventDescUserAgentName is the schema descriptor for userAgentName field.
+	auditeventDescUserAgentName := auditeventFields[21].Descriptor()
+	// auditevent.DefaultUserAgentName holds the default value on creation for the userAgentName field.
+	auditevent.DefaultUserAgentName = auditeventDescUserAgentName.Default.(string)
+	// auditeventDescRawSize is the schema descriptor for rawSize field.
+	auditeventDescRawSize := auditeventFields[22].Descriptor()
+	// auditevent.DefaultRawSize holds the default value on creation for the rawSize field.
+	auditevent.DefaultRawSize = auditeventDescRawSize.Default.(int)
+	// auditeventDescRawKeyID is the schema descriptor for rawKeyID field.
+	auditeventDescRawKeyID := auditeventFields[23].Descriptor()
+	// auditevent.DefaultRawKeyID holds the default value on creation for the rawKeyID field.
+	auditevent.DefaultRawKeyID = auditeventDescRawKeyID.Default.(string)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescKind is the schema descriptor for kind field.
+	deadletterDescKind := deadletterFields[0].Descriptor()
+	// deadletter.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	deadletter.KindValidator = deadletterDescKind.Validators[0].(func(string) error)
+	// deadletterDescCluster is the schema descriptor for cluster field.
+	deadletterDescCluster := deadletterFields[1].Descriptor()
+	// deadletter.DefaultCluster holds the default value on creation for the cluster field.
+	deadletter.DefaultCluster = deadletterDescCluster.Default.(string)
+	// deadletterDescRemoteAddr is the schema descriptor for remoteAddr field.
+	deadletterDescRemoteAddr := deadletterFields[2].Descriptor()
+	// deadletter.DefaultRemoteAddr holds the default value on creation for the remoteAddr field.
+	deadletter.DefaultRemoteAddr = deadletterDescRemoteAddr.Default.(string)
+	// deadletterDescPayload is the schema descriptor for payload field.
+	deadletterDescPayload := deadletterFields[4].Descriptor()
+	deadletter.ValueScanner.Payload = deadletterDescPayload.ValueScanner.(field.TypeValueScanner[string])
+	// deadletter.PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	deadletter.PayloadValidator = deadletterDescPayload.Validators[0].(func(string) error)
+	// deadletterDescPayloadKeyID is the schema descriptor for payloadKeyID field.
+	deadletterDescPayloadKeyID := deadletterFields[5].Descriptor()
+	// deadletter.DefaultPayloadKeyID holds the default value on creation for the payloadKeyID field.
+	deadletter.DefaultPayloadKeyID = deadletterDescPayloadKeyID.Default.(string)
+	// deadletterDescAttempts is the schema descriptor for attempts field.
+	deadletterDescAttempts := deadletterFields[6].Descriptor()
+	// deadletter.DefaultAttempts holds the default value on creation for the attempts field.
+	deadletter.DefaultAttempts = deadletterDescAttempts.Default.(int)
+	// deadletterDescReceivedAt is the schema descriptor for receivedAt field.
+	deadletterDescReceivedAt := deadletterFields[7].Descriptor()
+	// deadletter.DefaultReceivedAt holds the default value on creation for the receivedAt field.
+	deadletter.DefaultReceivedAt = deadletterDescReceivedAt.Default.(func() time.Time)
 	resourcekindFields := schema.ResourceKind{}.Fields()
 	_ = resourcekindFields
 	// resourcekindDescName is the schema descriptor for name field.
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditAnnotation holds the schema definition for the AuditAnnotation entity,
+// one annotation of an audit event such as authorization.k8s.io/decision.
+type AuditAnnotation struct {
+	ent.Schema
+}
+
+// Fields of the AuditAnnotation.
+func (AuditAnnotation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").NotEmpty().Immutable(),
+		field.Text("value").Immutable().Default(""),
+	}
+}
+
+// Edges of the AuditAnnotation.
+func (AuditAnnotation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", AuditEvent.Type).
+			Ref("annotations").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (AuditAnnotation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key"),
+	}
+}
@@ -3,9 +3,13 @@ package schema
 import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
 )
 
 // AuditEvent holds the schema definition for the AuditEvent entity.
@@ -13,18 +17,31 @@ type AuditEvent struct {
 	ent.Schema
 }
 
+// preciseTime keeps the microseconds of audit timestamps on MySQL, whose
+// default timestamp type truncates them and ends in 2038
+var preciseTime = map[string]string{dialect.MySQL: "datetime(6)"}
+
 // Fields of the AuditEvent.
 func (AuditEvent) Fields() []ent.Field {
 	return []ent.Field{
-		field.Text("raw").NotEmpty().Immutable(),
+		// raw is the full event JSON, stored zstd compressed by rawcodec and
+		// decompressed transparently when read. It is mutable so that rows
+		// stored uncompressed can be compressed in place. Compressed payloads are
+		// not valid text, hence the binary column types.
+		field.Text("raw").NotEmpty().
+			SchemaType(map[string]string{dialect.Postgres: "bytea", dialect.MySQL: "longblob"}).
+			ValueScanner(rawcodec.ValueScanner{}).
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
 		field.String("level").NotEmpty().Immutable(),
 		field.String("auditID").NotEmpty().Immutable(),
 		field.String("verb").NotEmpty().Immutable(),
 		field.String("userAgent").NotEmpty().Immutable(),
-		field.Time("requestTimestamp").Immutable().Annotations(
-			entgql.OrderField("REQUEST_TIMESTAMP"),
-		),
-		field.Time("stageTimestamp").Immutable().Annotations(
+		field.Time("requestTimestamp").Immutable().
+			SchemaType(preciseTime).
+			Annotations(
+				entgql.OrderField("REQUEST_TIMESTAMP"),
+			),
+		field.Time("stageTimestamp").Immutable().SchemaType(preciseTime).Annotations(
 			entgql.OrderField("STAGE_TIMESTAMP"),
 		),
 		field.String("namespace").Immutable().Default(""),
@@ -34,24 +51,60 @@ func (AuditEvent) Fields() []ent.Field {
 		field.String("resource").Immutable().Default(""),
 		field.String("subResource").Immutable().Default(""),
 		field.String("stage").Immutable(),
+		// cluster is the name of the cluster the event was received from
+		field.String("cluster").Immutable().Default("default"),
+		// sampleWeight is the number of received events the row stands for when
+		// reads are sampled at ingest, 1 for events that were not sampled
+		field.Int("sampleWeight").Immutable().Default(1),
+		// Fields below are extracted from raw at ingest. They are mutable so that
+		// rows stored before they existed can be backfilled.
+		field.String("username").Default(""),
+		field.Strings("userGroups").Optional(),
+		field.Strings("sourceIPs").Optional(),
+		// responseCode is the HTTP status of the response, 0 when the event has none
+		field.Int("responseCode").Default(0),
+		field.String("impersonatedUser").Default(""),
+		// userAgentName is the product of the user agent, e.g. "kubectl" for "kubectl/v1.30.0 (linux/amd64)"
+		field.String("userAgentName").Default(""),
+		// rawSize is the uncompressed size of raw in bytes when it is stored compressed, 0 when stored plain
+		field.Int("rawSize").Default(0),
+		// rawKeyID is the ID of the key raw is encrypted with, empty when stored unencrypted
+		field.String("rawKeyID").Default(""),
 	}
 }
 
 // Edges of the AuditEvent.
 func (AuditEvent) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("annotations", AuditAnnotation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 func (AuditEvent) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("level", "verb"),
 		index.Fields("verb"),
-		index.Fields("auditID"),
+		// The apiserver webhook backend retries batches, so an auditID may only be stored once per stage
+		index.Fields("auditID", "stage").Unique(),
 		index.Fields("userAgent"),
 		index.Fields("requestTimestamp"),
 		index.Fields("stageTimestamp"),
-		// Composite index for lifecycle query optimization
-		index.Fields("apiGroup", "apiVersion", "resource", "namespace", "name", "requestTimestamp"),
+		// Composite index for lifecycle query optimization. MySQL limits index keys to
+		// 3072 bytes, which five full utf8mb4 varchar(255) columns exceed.
+		index.Fields("apiGroup", "apiVersion", "resource", "namespace", "name", "requestTimestamp").
+			Annotations(
+				entsql.PrefixColumn("api_group", 128),
+				entsql.PrefixColumn("api_version", 64),
+				entsql.PrefixColumn("resource", 64),
+				entsql.PrefixColumn("namespace", 64),
+			),
+		// Per-cluster listing and last-seen lookups
+		index.Fields("cluster", "requestTimestamp"),
+		index.Fields("username", "requestTimestamp"),
+		index.Fields("impersonatedUser"),
+		index.Fields("responseCode"),
+		index.Fields("userAgentName"),
 	}
 }
 
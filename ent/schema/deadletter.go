@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity, a webhook
+// payload that could not be ingested, kept to be reprocessed once the cause is fixed.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		// kind is "body" for a request body that could not be decoded, "event"
+		// for a single event that failed validation
+		field.String("kind").NotEmpty().Immutable(),
+		// cluster is the cluster the request was attributed to, like the cluster of audit events
+		field.String("cluster").Immutable().Default("default"),
+		field.String("remoteAddr").Immutable().Default(""),
+		// reason is the error of the last attempt to ingest the payload
+		field.Text("reason"),
+		// payload is stored like the raw payload of audit events, compressed
+		// and encrypted by rawcodec as configured
+		field.Text("payload").NotEmpty().
+			SchemaType(map[string]string{dialect.Postgres: "bytea", dialect.MySQL: "longblob"}).
+			ValueScanner(rawcodec.ValueScanner{}).
+			Annotations(entgql.Skip(entgql.SkipWhereInput)),
+		// payloadKeyID is the ID of the key payload is encrypted with, empty when stored unencrypted
+		field.String("payloadKeyID").Default(""),
+		// attempts is the number of times the payload was reprocessed
+		field.Int("attempts").Default(0),
+		field.Time("receivedAt").Immutable().Default(time.Now).
+			SchemaType(preciseTime).
+			Annotations(entgql.OrderField("RECEIVED_AT")),
+	}
+}
+
+// Edges of the DeadLetter.
+func (DeadLetter) Edges() []ent.Edge {
+	return nil
+}
+
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receivedAt"),
+		index.Fields("kind", "receivedAt"),
+	}
+}
+
+func (DeadLetter) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
@@ -12,8 +12,12 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditAnnotation is the client for interacting with the AuditAnnotation builders.
+	AuditAnnotation *AuditAnnotationClient
 	// AuditEvent is the client for interacting with the AuditEvent builders.
 	AuditEvent *AuditEventClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// ResourceKind is the client for interacting with the ResourceKind builders.
 	ResourceKind *ResourceKindClient
 	// View is the client for interacting with the View builders.
@@ -149,7 +153,9 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditAnnotation = NewAuditAnnotationClient(tx.config)
 	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
 	tx.ResourceKind = NewResourceKindClient(tx.config)
 	tx.View = NewViewClient(tx.config)
 }
@@ -161,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
+// applies a query, for example: AuditAnnotation.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,29 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/annotation"
+)
+
+// AnnotationKeys is the resolver for the annotationKeys field.
+func (r *queryResolver) AnnotationKeys(ctx context.Context, prefix *string) ([]*AnnotationKeyCount, error) {
+	keyPrefix := ""
+	if prefix != nil {
+		keyPrefix = *prefix
+	}
+	counts, err := annotation.ListKeys(ctx, r.entClient, keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AnnotationKeyCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &AnnotationKeyCount{Key: count.Key, Count: count.Count})
+	}
+	return result, nil
+}
@@ -6,14 +6,16 @@ package gql
 
 import (
 	"context"
+	"time"
 
 	"github.com/strrl/kubernetes-auditing-dashboard/ent"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/annotation"
 )
 
 // CompletedRequestResponseAuditEvents is the resolver for the completedRequestResponseAuditEvents field.
-func (r *queryResolver) CompletedRequestResponseAuditEvents(ctx context.Context, page *int, pageSize *int, verbs []string, resources []string, userAgents []string) (*AuditEventPagination, error) {
+func (r *queryResolver) CompletedRequestResponseAuditEvents(ctx context.Context, page *int, pageSize *int, verbs []string, resources []string, userAgents []string, clusters []string, usernames []string, userGroups []string, sourceIPs []string, responseCodes []int, impersonatedUsers []string, annotations []*AnnotationFilter, from *time.Time, to *time.Time, namespaces []string, names []string, namePrefix *string, apiGroups []string, orderBy *AuditEventSort) (*AuditEventPagination, error) {
 	// Build base query with filters
 	buildQuery := func() *ent.AuditEventQuery {
 		q := r.Resolver.entClient.AuditEvent.Query().
@@ -40,16 +42,77 @@ func (r *queryResolver) CompletedRequestResponseAuditEvents(ctx context.Context,
 			q = q.Where(auditevent.Or(predicates...))
 		}
 
+		// Apply cluster filter if provided
+		if len(clusters) > 0 {
+			q = q.Where(auditevent.ClusterIn(clusters...))
+		}
+
+		// Apply user filters if provided
+		if len(usernames) > 0 {
+			q = q.Where(auditevent.UsernameIn(usernames...))
+		}
+		if len(userGroups) > 0 {
+			q = q.Where(jsonArrayContainsAny(auditevent.FieldUserGroups, userGroups))
+		}
+		if len(impersonatedUsers) > 0 {
+			q = q.Where(auditevent.ImpersonatedUserIn(impersonatedUsers...))
+		}
+
+		// Apply source IP filter if provided
+		if len(sourceIPs) > 0 {
+			q = q.Where(jsonArrayContainsAny(auditevent.FieldSourceIPs, sourceIPs))
+		}
+
+		// Apply response code filter if provided
+		if len(responseCodes) > 0 {
+			q = q.Where(auditevent.ResponseCodeIn(responseCodes...))
+		}
+
+		// Apply request time range if provided
+		if from != nil {
+			q = q.Where(auditevent.RequestTimestampGTE(*from))
+		}
+		if to != nil {
+			q = q.Where(auditevent.RequestTimestampLT(*to))
+		}
+
+		// Apply object filters if provided
+		if len(namespaces) > 0 {
+			q = q.Where(auditevent.NamespaceIn(namespaces...))
+		}
+		if len(names) > 0 {
+			q = q.Where(auditevent.NameIn(names...))
+		}
+		if namePrefix != nil && *namePrefix != "" {
+			q = q.Where(auditevent.NameHasPrefix(*namePrefix))
+		}
+		if len(apiGroups) > 0 {
+			q = q.Where(auditevent.ApiGroupIn(apiGroups...))
+		}
+
+		// Apply annotation filters if provided, every annotation has to match
+		if len(annotations) > 0 {
+			filters := make([]annotation.Filter, len(annotations))
+			for i, a := range annotations {
+				filters[i] = annotation.Filter{Key: a.Key, Value: a.Value}
+			}
+			q = q.Where(annotation.Matching(filters))
+		}
+
 		return q
 	}
 
+	realPage, realPageSize, err := r.pagination(page, pageSize)
+	if err != nil {
+		return nil, err
+	}
 	count, err := buildQuery().Count(ctx)
 	if err != nil {
 		return nil, err
 	}
-	offset, limit := paginationToSQL(page, pageSize)
+	offset, limit := paginationToSQL(realPage, realPageSize)
 	rows, err := buildQuery().
-		Order(ent.Desc(auditevent.FieldID)).
+		Order(auditEventOrder(orderBy)...).
 		Offset(offset).
 		Limit(limit).
 		All(ctx)
@@ -58,19 +121,11 @@ func (r *queryResolver) CompletedRequestResponseAuditEvents(ctx context.Context,
 		return nil, err
 	}
 
-	realPage := DefaultPage
-	realPageSize := DefaultPageSize
-	if page != nil {
-		realPage = *page
-	}
-	if pageSize != nil {
-		realPageSize = *pageSize
-	}
 	result := &AuditEventPagination{
 		Total:           count,
 		Page:            realPage,
 		PageSize:        realPageSize,
-		TotalPages:      count/realPageSize + 1,
+		TotalPages:      totalPages(count, realPageSize),
 		HasNextPage:     count > (realPage+1)*realPageSize,
 		HasPreviousPage: realPage > 0,
 		Rows:            rows,
@@ -0,0 +1,171 @@
+package gql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/gql"
+)
+
+var listedBase = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// listedEvent is a completed RequestResponse mutation, listed by completedRequestResponseAuditEvents
+type listedEvent struct {
+	auditID   string
+	namespace string
+	name      string
+	apiGroup  string
+	username  string
+	code      int
+	// offset is the delay of the request after listedBase, the stage completes one minute later in reverse order
+	offset time.Duration
+}
+
+func createListedEvents(t *testing.T, client *ent.Client, events ...listedEvent) {
+	for _, e := range events {
+		requestTime := listedBase.Add(e.offset)
+		_, err := client.AuditEvent.Create().
+			SetRaw("{}").
+			SetLevel("RequestResponse").
+			SetAuditID(e.auditID).
+			SetVerb("update").
+			SetUserAgent("kubectl/v1.30.0").
+			SetRequestTimestamp(requestTime).
+			SetStageTimestamp(listedBase.Add(time.Hour - e.offset)).
+			SetStage("ResponseComplete").
+			SetNamespace(e.namespace).
+			SetName(e.name).
+			SetApiGroup(e.apiGroup).
+			SetApiVersion("v1").
+			SetResource("deployments").
+			SetUsername(e.username).
+			SetResponseCode(e.code).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+// listAuditIDs runs completedRequestResponseAuditEvents with the arguments and returns the audit IDs in order
+func listAuditIDs(t *testing.T, c *client.Client, arguments string) []string {
+	var resp struct {
+		CompletedRequestResponseAuditEvents struct {
+			Total int
+			Rows  []struct{ Auditid string }
+		}
+	}
+	c.MustPost(`{ completedRequestResponseAuditEvents(`+arguments+`) { total rows { auditid } } }`, &resp)
+	ids := make([]string, 0, len(resp.CompletedRequestResponseAuditEvents.Rows))
+	for _, row := range resp.CompletedRequestResponseAuditEvents.Rows {
+		ids = append(ids, row.Auditid)
+	}
+	assert.Equal(t, len(ids), resp.CompletedRequestResponseAuditEvents.Total)
+	return ids
+}
+
+func TestCompletedRequestResponseAuditEvents(t *testing.T) {
+	entClient := setupTestDB(t)
+	t.Cleanup(func() { entClient.Close() })
+	createListedEvents(t, entClient,
+		listedEvent{auditID: "a", namespace: "prod", name: "web-1", apiGroup: "apps", username: "carol", code: 200, offset: 0},
+		listedEvent{auditID: "b", namespace: "dev", name: "web-2", apiGroup: "apps", username: "alice", code: 409, offset: time.Hour},
+		listedEvent{auditID: "c", namespace: "prod", name: "settings", apiGroup: "", username: "bob", code: 200, offset: 2 * time.Hour},
+		listedEvent{auditID: "d", namespace: "prod", name: "web", apiGroup: "apps", username: "alice", code: 403, offset: 3 * time.Hour},
+	)
+	c := client.New(handler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{Resolvers: gql.NewResolver(entClient)})))
+
+	t.Run("should list the newest stored events first by default", func(t *testing.T) {
+		assert.Equal(t, []string{"d", "c", "b", "a"}, listAuditIDs(t, c, "pageSize: 10"))
+	})
+
+	t.Run("should filter on the half-open request time range", func(t *testing.T) {
+		at := func(offset time.Duration) string { return `"` + listedBase.Add(offset).Format(time.RFC3339) + `"` }
+		assert.Equal(t, []string{"c", "b"}, listAuditIDs(t, c, "from: "+at(time.Hour)+", to: "+at(3*time.Hour)), "from is inclusive and to exclusive")
+		assert.Equal(t, []string{"d", "c"}, listAuditIDs(t, c, "from: "+at(2*time.Hour)))
+		assert.Equal(t, []string{"a"}, listAuditIDs(t, c, "to: "+at(time.Minute)))
+	})
+
+	t.Run("should filter on namespaces", func(t *testing.T) {
+		assert.Equal(t, []string{"d", "c", "a"}, listAuditIDs(t, c, `namespaces: ["prod"]`))
+		assert.Equal(t, []string{"d", "c", "b", "a"}, listAuditIDs(t, c, `namespaces: ["prod", "dev"]`))
+	})
+
+	t.Run("should filter on exact names", func(t *testing.T) {
+		assert.Equal(t, []string{"d"}, listAuditIDs(t, c, `names: ["web"]`))
+		assert.Equal(t, []string{"c", "a"}, listAuditIDs(t, c, `names: ["web-1", "settings"]`))
+	})
+
+	t.Run("should filter on a name prefix", func(t *testing.T) {
+		assert.Equal(t, []string{"d", "b", "a"}, listAuditIDs(t, c, `namePrefix: "web"`))
+		assert.Equal(t, []string{"b"}, listAuditIDs(t, c, `namePrefix: "web-2"`))
+		assert.Equal(t, []string{"b"}, listAuditIDs(t, c, `namePrefix: "web", namespaces: ["dev"]`))
+	})
+
+	t.Run("should filter on API groups, the empty string being the core group", func(t *testing.T) {
+		assert.Equal(t, []string{"c"}, listAuditIDs(t, c, `apiGroups: [""]`))
+		assert.Equal(t, []string{"d", "b", "a"}, listAuditIDs(t, c, `apiGroups: ["apps"]`))
+	})
+
+	t.Run("should sort on every field in both directions", func(t *testing.T) {
+		for _, tt := range []struct {
+			orderBy string
+			want    []string
+		}{
+			{"{field: REQUEST_TIMESTAMP, direction: ASC}", []string{"a", "b", "c", "d"}},
+			{"{field: REQUEST_TIMESTAMP, direction: DESC}", []string{"d", "c", "b", "a"}},
+			{"{field: STAGE_TIMESTAMP, direction: ASC}", []string{"d", "c", "b", "a"}},
+			{"{field: STAGE_TIMESTAMP, direction: DESC}", []string{"a", "b", "c", "d"}},
+			// Equal values are ordered newest stored first
+			{"{field: NAMESPACE, direction: ASC}", []string{"b", "d", "c", "a"}},
+			{"{field: NAMESPACE, direction: DESC}", []string{"d", "c", "a", "b"}},
+			{"{field: NAME, direction: ASC}", []string{"c", "d", "a", "b"}},
+			{"{field: NAME, direction: DESC}", []string{"b", "a", "d", "c"}},
+			{"{field: USERNAME, direction: ASC}", []string{"d", "b", "c", "a"}},
+			{"{field: USERNAME, direction: DESC}", []string{"a", "c", "d", "b"}},
+			{"{field: RESPONSE_CODE, direction: ASC}", []string{"c", "a", "d", "b"}},
+			{"{field: RESPONSE_CODE, direction: DESC}", []string{"b", "d", "c", "a"}},
+		} {
+			assert.Equal(t, tt.want, listAuditIDs(t, c, "orderBy: "+tt.orderBy), tt.orderBy)
+		}
+	})
+
+	t.Run("should sort descending when no direction is given", func(t *testing.T) {
+		assert.Equal(t, []string{"d", "c", "b", "a"}, listAuditIDs(t, c, "orderBy: {field: REQUEST_TIMESTAMP}"))
+		assert.Equal(t, []string{"b", "d", "c", "a"}, listAuditIDs(t, c, "orderBy: {field: RESPONSE_CODE}"))
+	})
+
+	t.Run("should count the pages and reject invalid pages", func(t *testing.T) {
+		var resp struct {
+			CompletedRequestResponseAuditEvents struct{ TotalPages int }
+		}
+		c.MustPost(`{ completedRequestResponseAuditEvents(pageSize: 2) { totalPages } }`, &resp)
+		assert.Equal(t, 2, resp.CompletedRequestResponseAuditEvents.TotalPages)
+
+		for _, arguments := range []string{"pageSize: 0", "pageSize: -1", "page: -1"} {
+			err := c.Post(`{ completedRequestResponseAuditEvents(`+arguments+`) { total } }`, &resp)
+			assert.Error(t, err, arguments)
+		}
+	})
+
+	t.Run("should page through equal values without overlap", func(t *testing.T) {
+		var ids []string
+		for page := 0; page < 2; page++ {
+			var resp struct {
+				CompletedRequestResponseAuditEvents struct {
+					Rows []struct{ Auditid string }
+				}
+			}
+			c.MustPost(`query($page: Int) { completedRequestResponseAuditEvents(page: $page, pageSize: 2, orderBy: {field: NAMESPACE, direction: ASC}) { rows { auditid } } }`,
+				&resp, client.Var("page", page))
+			for _, row := range resp.CompletedRequestResponseAuditEvents.Rows {
+				ids = append(ids, row.Auditid)
+			}
+		}
+		assert.Equal(t, []string{"b", "d", "c", "a"}, ids)
+	})
+}
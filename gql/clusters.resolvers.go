@@ -0,0 +1,30 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/cluster"
+)
+
+// Clusters is the resolver for the clusters field.
+func (r *queryResolver) Clusters(ctx context.Context) ([]*ClusterSummary, error) {
+	summaries, err := cluster.ListClusters(ctx, r.entClient)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*ClusterSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, &ClusterSummary{
+			Name:                summary.Name,
+			EventCount:          summary.EventCount,
+			EstimatedEventCount: summary.EstimatedEventCount,
+			LastSeen:            summary.LastSeen,
+		})
+	}
+	return result, nil
+}
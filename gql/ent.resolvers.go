@@ -28,6 +28,14 @@ func (r *queryResolver) AuditEvents(ctx context.Context, after *entgql.Cursor[in
 	)
 }
 
+// DeadLetters is the resolver for the deadLetters field.
+func (r *queryResolver) DeadLetters(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.DeadLetterOrder, where *ent.DeadLetterWhereInput) (*ent.DeadLetterConnection, error) {
+	return r.entClient.DeadLetter.Query().Paginate(ctx, after, first, before, last,
+		ent.WithDeadLetterOrder(orderBy),
+		ent.WithDeadLetterFilter(where.Filter),
+	)
+}
+
 // ResourceKinds is the resolver for the resourceKinds field.
 func (r *queryResolver) ResourceKinds(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.ResourceKindWhereInput) (*ent.ResourceKindConnection, error) {
 	return r.entClient.ResourceKind.Query().Paginate(ctx, after, first, before, last,
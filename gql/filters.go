@@ -0,0 +1,50 @@
+package gql
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+)
+
+// jsonArrayContainsAny matches rows whose JSON array column contains at least one of the values
+func jsonArrayContainsAny(column string, values []string) predicate.AuditEvent {
+	return predicate.AuditEvent(func(s *sql.Selector) {
+		predicates := make([]*sql.Predicate, 0, len(values))
+		for _, value := range values {
+			predicates = append(predicates, sqljson.ValueContains(s.C(column), value))
+		}
+		s.Where(sql.Or(predicates...))
+	})
+}
+
+// auditEventOrder returns the ordering of an offset paginated list, newest stored
+// first when orderBy is nil. ID DESC breaks ties so that pages do not overlap.
+func auditEventOrder(orderBy *AuditEventSort) []auditevent.OrderOption {
+	newest := auditevent.ByID(sql.OrderDesc())
+	if orderBy == nil {
+		return []auditevent.OrderOption{newest}
+	}
+	// Descending unless ascending is asked for, like the schema default
+	direction := sql.OrderDesc()
+	if orderBy.Direction == entgql.OrderDirectionAsc {
+		direction = sql.OrderAsc()
+	}
+	var term auditevent.OrderOption
+	switch orderBy.Field {
+	case AuditEventSortFieldStageTimestamp:
+		term = auditevent.ByStageTimestamp(direction)
+	case AuditEventSortFieldNamespace:
+		term = auditevent.ByNamespace(direction)
+	case AuditEventSortFieldName:
+		term = auditevent.ByName(direction)
+	case AuditEventSortFieldUsername:
+		term = auditevent.ByUsername(direction)
+	case AuditEventSortFieldResponseCode:
+		term = auditevent.ByResponseCode(direction)
+	default:
+		term = auditevent.ByRequestTimestamp(direction)
+	}
+	return []auditevent.OrderOption{term, newest}
+}
@@ -18,7 +18,7 @@ import (
 )
 
 // ResourceLifecycle is the resolver for the resourceLifecycle field.
-func (r *queryResolver) ResourceLifecycle(ctx context.Context, apiGroup string, version string, kind string, namespace *string, name string) ([]*LifecycleEvent, error) {
+func (r *queryResolver) ResourceLifecycle(ctx context.Context, apiGroup string, version string, kind string, namespace *string, name string, cluster *string) ([]*LifecycleEvent, error) {
 	// Parse the input into ResourceIdentifier
 	gvk := fmt.Sprintf("%s-%s-%s", apiGroup, version, kind)
 	if apiGroup == "" {
@@ -52,8 +52,13 @@ func (r *queryResolver) ResourceLifecycle(ctx context.Context, apiGroup string,
 			auditevent.StageEQ("ResponseComplete"),
 		)
 
-	// Order by requestTimestamp DESC (newest first)
-	query = query.Order(ent.Desc(auditevent.FieldRequestTimestamp))
+	// Restrict to one cluster when requested
+	if cluster != nil && *cluster != "" {
+		query = query.Where(auditevent.ClusterEQ(*cluster))
+	}
+
+	// Order by requestTimestamp DESC (newest first), the ID breaks ties the same way on every database
+	query = query.Order(ent.Desc(auditevent.FieldRequestTimestamp), ent.Desc(auditevent.FieldID))
 
 	// Execute query
 	events, err := query.All(ctx)
@@ -158,9 +163,11 @@ func (r *queryResolver) ResourceLifecycle(ctx context.Context, apiGroup string,
 			}
 		}
 
-		// Get user from audit event
+		// Get user from the extracted column, parsing raw for rows not backfilled yet
 		user := "unknown"
-		if auditEvent.User.Username != "" {
+		if event.Username != "" {
+			user = event.Username
+		} else if auditEvent.User.Username != "" {
 			user = auditEvent.User.Username
 		}
 
@@ -194,15 +201,20 @@ func (r *queryResolver) ResourceLifecycle(ctx context.Context, apiGroup string,
 			Type:          eventType,
 			Timestamp:     event.RequestTimestamp,
 			User:          user,
+			Cluster:       event.Cluster,
 			ResourceState: resourceStateJSON,
 		}
 
 		// Calculate diff and previousState for update/patch events (not for create or delete)
 		if (event.Verb == "update" || event.Verb == "patch") && i < len(events)-1 {
-			// Find previous mutating event (skip GET/LIST/WATCH events)
+			// Find previous mutating event (skip GET/LIST/WATCH events) of the same cluster,
+			// same-named resources of other clusters being different objects
 			var prevState map[string]interface{}
 			var hasPrevState bool
 			for j := i + 1; j < len(events); j++ {
+				if events[j].Cluster != event.Cluster {
+					continue
+				}
 				prevEventType := lifecycle.MapVerbToEventType(events[j].Verb)
 				// Only use CREATE/UPDATE/DELETE events as previous state
 				if prevEventType == lifecycle.EventTypeCreate ||
@@ -13,6 +13,8 @@ import (
 	"github.com/strrl/kubernetes-auditing-dashboard/ent"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
 	"github.com/strrl/kubernetes-auditing-dashboard/gql"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -56,10 +58,10 @@ func createTestAuditEvent(client *ent.Client, ctx context.Context, verb, namespa
 			"namespace":  namespace,
 			"name":       name,
 		},
-		"requestReceivedTimestamp": timestamp.Format(time.RFC3339Nano),
-		"stageTimestamp":          timestamp.Format(time.RFC3339Nano),
-		"requestObject":           json.RawMessage(resourceJSON),
-		"responseObject":          json.RawMessage(resourceJSON),
+		"requestReceivedTimestamp": timestamp.Format(metav1.RFC3339Micro),
+		"stageTimestamp":           timestamp.Format(metav1.RFC3339Micro),
+		"requestObject":            json.RawMessage(resourceJSON),
+		"responseObject":           json.RawMessage(resourceJSON),
 	}
 
 	raw, err := json.Marshal(auditEvent)
@@ -115,7 +117,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		// Create resolver and query
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-app")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-app", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 4)
@@ -146,10 +148,10 @@ func TestResourceLifecycleQuery(t *testing.T) {
 				"resource":   "namespaces",
 				"name":       "production",
 			},
-			"requestReceivedTimestamp": time.Now().Format(time.RFC3339Nano),
-			"stageTimestamp":          time.Now().Format(time.RFC3339Nano),
-			"requestObject":  json.RawMessage(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"production"}}`),
-			"responseObject": json.RawMessage(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"production"}}`),
+			"requestReceivedTimestamp": time.Now().Format(metav1.RFC3339Micro),
+			"stageTimestamp":           time.Now().Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"production"}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"production"}}`),
 		}
 
 		raw, err := json.Marshal(auditEvent)
@@ -176,7 +178,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 
 		// Query without namespace
 		resolver := gql.NewResolver(client)
-		result, err := resolver.Query().ResourceLifecycle(ctx, "", "v1", "Namespace", nil, "production")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "", "v1", "Namespace", nil, "production", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
@@ -191,7 +193,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		// Query for non-existent resource
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "non-existent")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "non-existent", nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, result)
@@ -207,17 +209,17 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		namespace := "default"
 
 		// Test with empty name
-		_, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "")
+		_, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "name cannot be empty")
 
 		// Test with empty kind
-		_, err = resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "", &namespace, "test")
+		_, err = resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "", &namespace, "test", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "kind cannot be empty")
 
 		// Test with empty version
-		_, err = resolver.Query().ResourceLifecycle(ctx, "apps", "", "Deployment", &namespace, "test")
+		_, err = resolver.Query().ResourceLifecycle(ctx, "apps", "", "Deployment", &namespace, "test", nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "version cannot be empty")
 	})
@@ -242,7 +244,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		// Query all events
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "high-volume-app")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "high-volume-app", nil)
 
 		require.NoError(t, err)
 		assert.Len(t, result, 120)
@@ -277,7 +279,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		// Query and verify order
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "order-test")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "order-test", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 4)
@@ -307,7 +309,7 @@ func TestResourceLifecycleQuery(t *testing.T) {
 		// Query all events - should not cause memory issues
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "large-app")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "large-app", nil)
 
 		require.NoError(t, err)
 		assert.Len(t, result, 500)
@@ -337,10 +339,10 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-diff",
 			},
-			"requestReceivedTimestamp": now.Add(-1 * time.Hour).Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Add(-1 * time.Hour).Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":1}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":1}}`),
+			"requestReceivedTimestamp": now.Add(-1 * time.Hour).Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Add(-1 * time.Hour).Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":1}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":1}}`),
 		}
 
 		raw1, _ := json.Marshal(createEvent)
@@ -372,10 +374,10 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-diff",
 			},
-			"requestReceivedTimestamp": now.Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":3}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":3}}`),
+			"requestReceivedTimestamp": now.Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":3}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-diff"},"spec":{"replicas":3}}`),
 		}
 
 		raw2, _ := json.Marshal(updateEvent)
@@ -400,14 +402,14 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 		// Query and check diff
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-diff")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-diff", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 2)
 
 		// First event (most recent) should be UPDATE with diff
 		updateResult := result[0]
-		assert.Equal(t, gql.EventTypeUpdate, updateResult.Type)
+		assert.Equal(t, "update", updateResult.Type)
 		assert.NotNil(t, updateResult.Diff)
 		assert.NotEmpty(t, updateResult.Diff.Modified)
 	})
@@ -424,13 +426,13 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 		// Query
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "new-app")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "new-app", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
 
 		// CREATE event should not have diff
-		assert.Equal(t, gql.EventTypeCreate, result[0].Type)
+		assert.Equal(t, "create", result[0].Type)
 		assert.Nil(t, result[0].Diff)
 	})
 
@@ -446,13 +448,13 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 		// Query
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "deleted-app")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "deleted-app", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
 
 		// DELETE event should not have diff
-		assert.Equal(t, gql.EventTypeDelete, result[0].Type)
+		assert.Equal(t, "delete", result[0].Type)
 		assert.Nil(t, result[0].Diff)
 	})
 }
@@ -460,16 +462,16 @@ func TestResourceLifecycle_DiffComputation(t *testing.T) {
 func TestResourceLifecycle_EventTypeMapping(t *testing.T) {
 	tests := []struct {
 		verb         string
-		expectedType gql.EventType
+		expectedType string
 	}{
-		{"create", gql.EventTypeCreate},
-		{"update", gql.EventTypeUpdate},
-		{"patch", gql.EventTypeUpdate},
-		{"delete", gql.EventTypeDelete},
+		{"create", "create"},
+		{"update", "update"},
+		{"patch", "patch"},
+		{"delete", "delete"},
 	}
 
 	for _, tt := range tests {
-		t.Run("should map verb "+tt.verb+" to "+string(tt.expectedType), func(t *testing.T) {
+		t.Run("should report verb "+tt.verb+" as "+tt.expectedType, func(t *testing.T) {
 			ctx := context.Background()
 			client := setupTestDB(t)
 			defer client.Close()
@@ -481,7 +483,7 @@ func TestResourceLifecycle_EventTypeMapping(t *testing.T) {
 			// Query
 			resolver := gql.NewResolver(client)
 			namespace := "default"
-			result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-type")
+			result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-type", nil)
 
 			require.NoError(t, err)
 			require.Len(t, result, 1)
@@ -508,8 +510,8 @@ func TestResourceLifecycle_UserExtraction(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "apps/v1",
 				"resource": "deployments", "namespace": "default", "name": "user-test",
 			},
-			"requestReceivedTimestamp": time.Now().Format(time.RFC3339Nano),
-			"stageTimestamp":          time.Now().Format(time.RFC3339Nano),
+			"requestReceivedTimestamp": time.Now().Format(metav1.RFC3339Micro),
+			"stageTimestamp":           time.Now().Format(metav1.RFC3339Micro),
 			"responseObject": map[string]interface{}{
 				"raw": []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"user-test"}}`),
 			},
@@ -537,7 +539,7 @@ func TestResourceLifecycle_UserExtraction(t *testing.T) {
 		// Query
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "user-test")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "user-test", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
@@ -573,7 +575,7 @@ func TestResourceLifecycle_ErrorHandling(t *testing.T) {
 		// Query should handle the malformed event gracefully
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "malformed-test")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "malformed-test", nil)
 
 		// Should not error, but may skip malformed events
 		require.NoError(t, err)
@@ -600,10 +602,10 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-prev-state",
 			},
-			"requestReceivedTimestamp": now.Add(-2 * time.Hour).Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Add(-2 * time.Hour).Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":1}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":1}}`),
+			"requestReceivedTimestamp": now.Add(-2 * time.Hour).Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Add(-2 * time.Hour).Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":1}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":1}}`),
 		}
 
 		raw1, _ := json.Marshal(createEvent)
@@ -635,10 +637,10 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-prev-state",
 			},
-			"requestReceivedTimestamp": now.Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":3}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":3}}`),
+			"requestReceivedTimestamp": now.Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":3}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-prev-state"},"spec":{"replicas":3}}`),
 		}
 
 		raw2, _ := json.Marshal(updateEvent)
@@ -663,14 +665,14 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 		// Query and verify
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-prev-state")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-prev-state", nil)
 
 		require.NoError(t, err)
 		require.Len(t, result, 2)
 
 		// First event (UPDATE) should have previousState
 		updateResult := result[0]
-		assert.Equal(t, gql.EventTypeUpdate, updateResult.Type)
+		assert.Equal(t, "update", updateResult.Type)
 		assert.NotNil(t, updateResult.PreviousState, "UPDATE event should have previousState")
 
 		// Parse previous state and verify it has replicas=1
@@ -687,7 +689,7 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 
 		// CREATE event should not have previousState
 		createResult := result[1]
-		assert.Equal(t, gql.EventTypeCreate, createResult.Type)
+		assert.Equal(t, "create", createResult.Type)
 		assert.Nil(t, createResult.PreviousState, "CREATE event should not have previousState")
 	})
 
@@ -708,10 +710,10 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-skip-gets",
 			},
-			"requestReceivedTimestamp": now.Add(-4 * time.Hour).Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Add(-4 * time.Hour).Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":1}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":1}}`),
+			"requestReceivedTimestamp": now.Add(-4 * time.Hour).Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Add(-4 * time.Hour).Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":1}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":1}}`),
 		}
 
 		raw1, _ := json.Marshal(createEvent)
@@ -757,9 +759,9 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 					"apiGroup": "apps", "apiVersion": "v1",
 					"resource": "deployments", "namespace": "default", "name": "test-skip-gets",
 				},
-				"requestReceivedTimestamp": now.Add(time.Duration(-3+i) * time.Hour).Format(time.RFC3339Nano),
-				"stageTimestamp":          now.Add(time.Duration(-3+i) * time.Hour).Format(time.RFC3339Nano),
-				"responseObject":          json.RawMessage(resourceJSON),
+				"requestReceivedTimestamp": now.Add(time.Duration(-3+i) * time.Hour).Format(metav1.RFC3339Micro),
+				"stageTimestamp":           now.Add(time.Duration(-3+i) * time.Hour).Format(metav1.RFC3339Micro),
+				"responseObject":           json.RawMessage(resourceJSON),
 			}
 
 			rawGet, _ := json.Marshal(getEvent)
@@ -792,10 +794,10 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 				"apiGroup": "apps", "apiVersion": "v1",
 				"resource": "deployments", "namespace": "default", "name": "test-skip-gets",
 			},
-			"requestReceivedTimestamp": now.Format(time.RFC3339Nano),
-			"stageTimestamp":          now.Format(time.RFC3339Nano),
-			"requestObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":5}}`),
-			"responseObject":          json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":5}}`),
+			"requestReceivedTimestamp": now.Format(metav1.RFC3339Micro),
+			"stageTimestamp":           now.Format(metav1.RFC3339Micro),
+			"requestObject":            json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":5}}`),
+			"responseObject":           json.RawMessage(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-skip-gets"},"spec":{"replicas":5}}`),
 		}
 
 		rawUpdate, _ := json.Marshal(updateEvent)
@@ -820,7 +822,7 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 		// Query and verify
 		resolver := gql.NewResolver(client)
 		namespace := "default"
-		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-skip-gets")
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "test-skip-gets", nil)
 
 		require.NoError(t, err)
 		// Should have UPDATE + GET events + CREATE = 5 events total
@@ -828,7 +830,7 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 
 		// First event should be UPDATE
 		updateResult := result[0]
-		assert.Equal(t, gql.EventTypeUpdate, updateResult.Type)
+		assert.Equal(t, "update", updateResult.Type)
 		assert.NotNil(t, updateResult.PreviousState, "UPDATE event should have previousState")
 
 		// Previous state should be from CREATE (replicas=1), not from GET events
@@ -844,4 +846,65 @@ func TestResourceLifecycle_PreviousState(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, float64(5), currentState["spec"].(map[string]interface{})["replicas"])
 	})
-}
\ No newline at end of file
+}
+
+func TestResourceLifecycle_Clusters(t *testing.T) {
+	t.Run("should diff events against the previous event of the same cluster", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		defer client.Close()
+
+		now := time.Now()
+		store := func(auditID, cluster, verb string, replicas int, timestamp time.Time) {
+			object := json.RawMessage(fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web"},"spec":{"replicas":%d}}`, replicas))
+			raw, _ := json.Marshal(map[string]interface{}{
+				"level":   "RequestResponse",
+				"auditID": auditID,
+				"verb":    verb,
+				"user":    map[string]interface{}{"username": "admin"},
+				"objectRef": map[string]interface{}{
+					"apiGroup": "apps", "apiVersion": "v1",
+					"resource": "deployments", "namespace": "default", "name": "web",
+				},
+				"requestReceivedTimestamp": timestamp.Format(metav1.RFC3339Micro),
+				"stageTimestamp":           timestamp.Format(metav1.RFC3339Micro),
+				"requestObject":            object,
+				"responseObject":           object,
+			})
+			_, err := client.AuditEvent.Create().
+				SetRaw(string(raw)).
+				SetLevel("RequestResponse").
+				SetAuditID(auditID).
+				SetVerb(verb).
+				SetUserAgent("kubectl").
+				SetRequestTimestamp(timestamp).
+				SetStageTimestamp(timestamp).
+				SetNamespace("default").
+				SetName("web").
+				SetApiVersion("v1").
+				SetApiGroup("apps").
+				SetResource("deployments").
+				SetStage("ResponseComplete").
+				SetCluster(cluster).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+		store("prod-create", "prod", "create", 1, now.Add(-3*time.Hour))
+		store("staging-create", "staging", "create", 5, now.Add(-2*time.Hour))
+		store("prod-update", "prod", "update", 2, now.Add(-time.Hour))
+
+		resolver := gql.NewResolver(client)
+		namespace := "default"
+		result, err := resolver.Query().ResourceLifecycle(ctx, "apps", "v1", "Deployment", &namespace, "web", nil)
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+
+		update := result[0]
+		assert.Equal(t, "prod", update.Cluster)
+		require.NotNil(t, update.PreviousState)
+		var prevState map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(*update.PreviousState), &prevState))
+		assert.Equal(t, float64(1), prevState["spec"].(map[string]interface{})["replicas"], "the staging deployment is another object")
+		assert.Equal(t, "staging", result[1].Cluster)
+	})
+}
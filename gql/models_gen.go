@@ -3,11 +3,30 @@
 package gql
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"github.com/strrl/kubernetes-auditing-dashboard/ent"
 )
 
+// Matches audit events carrying an annotation
+type AnnotationFilter struct {
+	// Annotation key, e.g. authorization.k8s.io/decision
+	Key string `json:"key"`
+	// Annotation value, omit to match any value of the key
+	Value *string `json:"value,omitempty"`
+}
+
+// Number of audit events carrying an annotation key
+type AnnotationKeyCount struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
 type AuditEventPagination struct {
 	Total           int               `json:"total"`
 	Page            int               `json:"page"`
@@ -18,6 +37,25 @@ type AuditEventPagination struct {
 	Rows            []*ent.AuditEvent `json:"rows"`
 }
 
+// Sort order of completedRequestResponseAuditEvents. Rows with equal values are
+// ordered newest stored first, so that pages do not overlap.
+type AuditEventSort struct {
+	Field     AuditEventSortField   `json:"field"`
+	Direction entgql.OrderDirection `json:"direction"`
+}
+
+// Summary of the audit events stored for one cluster
+type ClusterSummary struct {
+	// Cluster name, as given by the webhook path or the credential mapping
+	Name string `json:"name"`
+	// Number of stored audit events
+	EventCount int `json:"eventCount"`
+	// Number of audit events received, counting the reads dropped by sampling through the sample weights
+	EstimatedEventCount int `json:"estimatedEventCount"`
+	// Request timestamp of the most recent audit event
+	LastSeen *time.Time `json:"lastSeen,omitempty"`
+}
+
 // Represents a single field change in a diff
 type DiffEntry struct {
 	// JSON path to the changed field (e.g., "spec.replicas")
@@ -38,6 +76,8 @@ type LifecycleEvent struct {
 	Timestamp time.Time `json:"timestamp"`
 	// User or service account that triggered the event
 	User string `json:"user"`
+	// Cluster the event was received from
+	Cluster string `json:"cluster"`
 	// Complete resource state at the time of this event (YAML as JSON)
 	ResourceState string `json:"resourceState"`
 	// Previous resource state before this event (YAML as JSON). Only populated for UPDATE events.
@@ -46,6 +86,19 @@ type LifecycleEvent struct {
 	Diff *ResourceDiff `json:"diff,omitempty"`
 }
 
+// Space taken by raw audit payloads
+type RawStorageStats struct {
+	Rows int `json:"rows"`
+	// Rows stored before compression, compressed by the compress-raw command
+	PendingRows int `json:"pendingRows"`
+	// Size of the payloads as stored, in bytes
+	StoredBytes int `json:"storedBytes"`
+	// Size of the payloads once decompressed, in bytes
+	UncompressedBytes int `json:"uncompressedBytes"`
+	// uncompressedBytes over storedBytes
+	Ratio float64 `json:"ratio"`
+}
+
 // Represents the diff between two consecutive resource versions
 type ResourceDiff struct {
 	// Fields that were added in this update
@@ -55,3 +108,66 @@ type ResourceDiff struct {
 	// Fields that were modified, with old and new values
 	Modified []*DiffEntry `json:"modified"`
 }
+
+type AuditEventSortField string
+
+const (
+	AuditEventSortFieldRequestTimestamp AuditEventSortField = "REQUEST_TIMESTAMP"
+	AuditEventSortFieldStageTimestamp   AuditEventSortField = "STAGE_TIMESTAMP"
+	AuditEventSortFieldNamespace        AuditEventSortField = "NAMESPACE"
+	AuditEventSortFieldName             AuditEventSortField = "NAME"
+	AuditEventSortFieldUsername         AuditEventSortField = "USERNAME"
+	AuditEventSortFieldResponseCode     AuditEventSortField = "RESPONSE_CODE"
+)
+
+var AllAuditEventSortField = []AuditEventSortField{
+	AuditEventSortFieldRequestTimestamp,
+	AuditEventSortFieldStageTimestamp,
+	AuditEventSortFieldNamespace,
+	AuditEventSortFieldName,
+	AuditEventSortFieldUsername,
+	AuditEventSortFieldResponseCode,
+}
+
+func (e AuditEventSortField) IsValid() bool {
+	switch e {
+	case AuditEventSortFieldRequestTimestamp, AuditEventSortFieldStageTimestamp, AuditEventSortFieldNamespace, AuditEventSortFieldName, AuditEventSortFieldUsername, AuditEventSortFieldResponseCode:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventSortField) String() string {
+	return string(e)
+}
+
+func (e *AuditEventSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventSortField", str)
+	}
+	return nil
+}
+
+func (e AuditEventSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditEventSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditEventSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -1,14 +1,37 @@
 package gql
 
+import "fmt"
+
 const DefaultPage = 0
+
+// DefaultPageSize is the number of audit events per page when the query does not ask for one
 const DefaultPageSize = 10
 
-func paginationToSQL(page, pageSize *int) (offset, limit int) {
-	if page == nil {
-		return 0, DefaultPageSize
+// paginationToSQL converts a resolved page into an offset and a limit
+func paginationToSQL(page, pageSize int) (offset, limit int) {
+	return page * pageSize, pageSize
+}
+
+// totalPages is the number of pages needed for count rows
+func totalPages(count, pageSize int) int {
+	return (count + pageSize - 1) / pageSize
+}
+
+// pagination resolves the page arguments, falling back to the configured default
+// page size, and rejects negative pages and page sizes that are not positive
+func (r *Resolver) pagination(page, pageSize *int) (realPage, realPageSize int, err error) {
+	realPage, realPageSize = DefaultPage, r.defaultPageSize
+	if page != nil {
+		realPage = *page
+	}
+	if pageSize != nil {
+		realPageSize = *pageSize
+	}
+	if realPage < 0 {
+		return 0, 0, fmt.Errorf("page must not be negative, got %d", realPage)
 	}
-	if pageSize == nil {
-		return *page * DefaultPageSize, DefaultPageSize
+	if realPageSize <= 0 {
+		return 0, 0, fmt.Errorf("pageSize must be positive, got %d", realPageSize)
 	}
-	return *page * *pageSize, *pageSize
+	return realPage, realPageSize, nil
 }
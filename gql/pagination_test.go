@@ -1,80 +1,26 @@
 package gql
 
 import (
-	pointer "k8s.io/utils/pointer"
 	"testing"
+
+	pointer "k8s.io/utils/pointer"
 )
 
 func Test_paginationToSQL(t *testing.T) {
-	type args struct {
-		page     *int
-		pageSize *int
-	}
 	tests := []struct {
 		name       string
-		args       args
+		page       int
+		pageSize   int
 		wantOffset int
 		wantLimit  int
 	}{
-		{
-			name: "both are nil",
-			args: args{
-				page:     nil,
-				pageSize: nil,
-			},
-			wantOffset: 0,
-			wantLimit:  10,
-		},
-		{
-			name: "page is nil",
-			args: args{
-				page:     nil,
-				pageSize: pointer.Int(10),
-			},
-			wantOffset: 0,
-			wantLimit:  10,
-		},
-		{
-			name: "pageSize is nil",
-			args: args{
-				page:     pointer.Int(1),
-				pageSize: nil,
-			},
-			wantOffset: 10,
-			wantLimit:  10,
-		},
-
-		{
-			name: "both are not nil",
-			args: args{
-				page:     pointer.Int(1),
-				pageSize: pointer.Int(10),
-			},
-			wantOffset: 10,
-			wantLimit:  10,
-		},
-		{
-			name: "first page",
-			args: args{
-				page:     pointer.Int(0),
-				pageSize: pointer.Int(10),
-			},
-			wantOffset: 0,
-			wantLimit:  10,
-		},
-		{
-			name: "page 3 pageSize 20",
-			args: args{
-				page:     pointer.Int(3),
-				pageSize: pointer.Int(20),
-			},
-			wantOffset: 60,
-			wantLimit:  20,
-		},
+		{name: "first page", page: 0, pageSize: 10, wantOffset: 0, wantLimit: 10},
+		{name: "second page", page: 1, pageSize: 10, wantOffset: 10, wantLimit: 10},
+		{name: "page 3 pageSize 20", page: 3, pageSize: 20, wantOffset: 60, wantLimit: 20},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotOffset, gotLimit := paginationToSQL(tt.args.page, tt.args.pageSize)
+			gotOffset, gotLimit := paginationToSQL(tt.page, tt.pageSize)
 			if gotOffset != tt.wantOffset {
 				t.Errorf("paginationToSQL() gotOffset = %v, want %v", gotOffset, tt.wantOffset)
 			}
@@ -84,3 +30,51 @@ func Test_paginationToSQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_totalPages(t *testing.T) {
+	tests := []struct {
+		count int
+		want  int
+	}{
+		{count: 0, want: 0},
+		{count: 1, want: 1},
+		{count: 10, want: 1},
+		{count: 11, want: 2},
+		{count: 20, want: 2},
+	}
+	for _, tt := range tests {
+		if got := totalPages(tt.count, 10); got != tt.want {
+			t.Errorf("totalPages(%d, 10) = %v, want %v", tt.count, got, tt.want)
+		}
+	}
+}
+
+func Test_pagination(t *testing.T) {
+	r := NewResolver(nil, WithDefaultPageSize(25))
+	tests := []struct {
+		name         string
+		page         *int
+		pageSize     *int
+		wantPage     int
+		wantPageSize int
+		wantErr      bool
+	}{
+		{name: "both are nil", wantPage: 0, wantPageSize: 25},
+		{name: "pageSize is nil", page: pointer.Int(2), wantPage: 2, wantPageSize: 25},
+		{name: "both are set", page: pointer.Int(1), pageSize: pointer.Int(10), wantPage: 1, wantPageSize: 10},
+		{name: "negative page", page: pointer.Int(-1), wantErr: true},
+		{name: "zero pageSize", pageSize: pointer.Int(0), wantErr: true},
+		{name: "negative pageSize", pageSize: pointer.Int(-5), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, gotPageSize, err := r.pagination(tt.page, tt.pageSize)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pagination() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotPage != tt.wantPage || gotPageSize != tt.wantPageSize {
+				t.Errorf("pagination() = %v, %v, want %v, %v", gotPage, gotPageSize, tt.wantPage, tt.wantPageSize)
+			}
+		})
+	}
+}
@@ -7,9 +7,26 @@ import "github.com/strrl/kubernetes-auditing-dashboard/ent"
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	entClient *ent.Client
+	entClient       *ent.Client
+	defaultPageSize int
 }
 
-func NewResolver(entClient *ent.Client) *Resolver {
-	return &Resolver{entClient: entClient}
+// Option configures a Resolver
+type Option func(*Resolver)
+
+// WithDefaultPageSize sets the page size of queries that do not ask for one
+func WithDefaultPageSize(pageSize int) Option {
+	return func(r *Resolver) {
+		if pageSize > 0 {
+			r.defaultPageSize = pageSize
+		}
+	}
+}
+
+func NewResolver(entClient *ent.Client, opts ...Option) *Resolver {
+	r := &Resolver{entClient: entClient, defaultPageSize: DefaultPageSize}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
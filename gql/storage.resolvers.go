@@ -0,0 +1,26 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// RawStorageStats is the resolver for the rawStorageStats field.
+func (r *queryResolver) RawStorageStats(ctx context.Context) (*RawStorageStats, error) {
+	stats, err := ingestion.GetRawStorageStats(ctx, r.entClient)
+	if err != nil {
+		return nil, err
+	}
+	return &RawStorageStats{
+		Rows:              int(stats.Rows),
+		PendingRows:       int(stats.PendingRows),
+		StoredBytes:       int(stats.StoredBytes),
+		UncompressedBytes: int(stats.UncompressedBytes),
+		Ratio:             stats.Ratio(),
+	}, nil
+}
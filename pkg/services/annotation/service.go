@@ -0,0 +1,63 @@
+package annotation
+
+import (
+	"context"
+	"sort"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+)
+
+// KeyCount is the number of stored annotations with one key
+type KeyCount struct {
+	Key   string
+	Count int
+}
+
+// Filter matches events carrying an annotation with the key, and the value when set
+type Filter struct {
+	Key   string
+	Value *string
+}
+
+// ListKeys returns the distinct annotation keys starting with prefix, from the most to the least frequent
+func ListKeys(ctx context.Context, client *ent.Client, prefix string) ([]*KeyCount, error) {
+	query := client.AuditAnnotation.Query()
+	if prefix != "" {
+		query = query.Where(auditannotation.KeyHasPrefix(prefix))
+	}
+	var groups []struct {
+		Key   string `json:"key"`
+		Count int    `json:"count"`
+	}
+	if err := query.GroupBy(auditannotation.FieldKey).Aggregate(ent.Count()).Scan(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	counts := make([]*KeyCount, 0, len(groups))
+	for _, group := range groups {
+		counts = append(counts, &KeyCount{Key: group.Key, Count: group.Count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Key < counts[j].Key
+	})
+	return counts, nil
+}
+
+// Matching returns a predicate on audit events satisfying every filter
+func Matching(filters []Filter) predicate.AuditEvent {
+	predicates := make([]predicate.AuditEvent, 0, len(filters))
+	for _, filter := range filters {
+		conditions := []predicate.AuditAnnotation{auditannotation.KeyEQ(filter.Key)}
+		if filter.Value != nil {
+			conditions = append(conditions, auditannotation.ValueEQ(*filter.Value))
+		}
+		predicates = append(predicates, auditevent.HasAnnotationsWith(conditions...))
+	}
+	return auditevent.And(predicates...)
+}
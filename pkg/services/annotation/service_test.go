@@ -0,0 +1,111 @@
+package annotation_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/annotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	dbName := fmt.Sprintf("file:annotation_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63())
+	client := enttest.Open(t, "sqlite3", dbName)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func newAnnotatedEvent(auditID string, annotations map[string]string) auditv1.Event {
+	now := metav1.NewMicroTime(time.Now())
+	return auditv1.Event{
+		Level:                    auditv1.LevelRequestResponse,
+		AuditID:                  types.UID(auditID),
+		Stage:                    auditv1.StageResponseComplete,
+		Verb:                     "create",
+		UserAgent:                "kubectl/v1.30.0",
+		RequestReceivedTimestamp: now,
+		StageTimestamp:           now,
+		Annotations:              annotations,
+	}
+}
+
+func ingestAnnotated(t *testing.T, client *ent.Client) {
+	_, err := ingestion.NewService(client).Ingest(context.Background(), "", []auditv1.Event{
+		newAnnotatedEvent("allowed", map[string]string{
+			"authorization.k8s.io/decision":             "allow",
+			"authorization.k8s.io/reason":               "RBAC: allowed by ClusterRoleBinding",
+			"pod-security.kubernetes.io/enforce-policy": "restricted:latest",
+		}),
+		newAnnotatedEvent("forbidden", map[string]string{
+			"authorization.k8s.io/decision": "forbid",
+		}),
+		newAnnotatedEvent("plain", nil),
+	})
+	require.NoError(t, err)
+}
+
+func TestListKeys(t *testing.T) {
+	t.Run("should count events per annotation key", func(t *testing.T) {
+		client := setupTestDB(t)
+		ingestAnnotated(t, client)
+
+		counts, err := annotation.ListKeys(context.Background(), client, "")
+		require.NoError(t, err)
+		require.Len(t, counts, 3)
+		assert.Equal(t, &annotation.KeyCount{Key: "authorization.k8s.io/decision", Count: 2}, counts[0])
+		assert.Equal(t, "authorization.k8s.io/reason", counts[1].Key)
+		assert.Equal(t, "pod-security.kubernetes.io/enforce-policy", counts[2].Key)
+	})
+
+	t.Run("should only list keys with the prefix", func(t *testing.T) {
+		client := setupTestDB(t)
+		ingestAnnotated(t, client)
+
+		counts, err := annotation.ListKeys(context.Background(), client, "pod-security.kubernetes.io/")
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, 1, counts[0].Count)
+	})
+}
+
+func TestMatching(t *testing.T) {
+	client := setupTestDB(t)
+	ingestAnnotated(t, client)
+	auditIDs := func(filters ...annotation.Filter) []string {
+		events, err := client.AuditEvent.Query().Where(annotation.Matching(filters)).All(context.Background())
+		require.NoError(t, err)
+		ids := make([]string, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.AuditID)
+		}
+		return ids
+	}
+	forbid := "forbid"
+
+	t.Run("should match events carrying the key", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"allowed", "forbidden"}, auditIDs(annotation.Filter{Key: "authorization.k8s.io/decision"}))
+	})
+
+	t.Run("should match events carrying the key with the value", func(t *testing.T) {
+		assert.Equal(t, []string{"forbidden"}, auditIDs(annotation.Filter{Key: "authorization.k8s.io/decision", Value: &forbid}))
+	})
+
+	t.Run("should require every filter to match", func(t *testing.T) {
+		assert.Empty(t, auditIDs(
+			annotation.Filter{Key: "authorization.k8s.io/decision", Value: &forbid},
+			annotation.Filter{Key: "authorization.k8s.io/reason"},
+		))
+	})
+}
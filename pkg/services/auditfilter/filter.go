@@ -0,0 +1,147 @@
+package auditfilter
+
+import (
+	"encoding/json"
+	"net/url"
+	"slices"
+	"sync/atomic"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"k8s.io/apimachinery/pkg/runtime"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/audit/policy"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// Stats counts what the filter did to the events it evaluated
+type Stats struct {
+	Evaluated  int64 `json:"evaluated"`
+	Dropped    int64 `json:"dropped"`
+	Downgraded int64 `json:"downgraded"`
+}
+
+// Filter applies an audit.k8s.io/v1 Policy to received events, as the apiserver
+// would have, so that noise can be dropped without editing the policy of every
+// control plane. The first matching rule decides the level of an event, and
+// events matching no rule are dropped, exactly like the apiserver does. A rule
+// can only lower the level recorded by the apiserver: request and response
+// bodies that were not sent cannot be restored.
+type Filter struct {
+	evaluator audit.PolicyRuleEvaluator
+
+	evaluated  atomic.Int64
+	dropped    atomic.Int64
+	downgraded atomic.Int64
+}
+
+// LoadFilter reads an audit.k8s.io/v1 Policy file
+func LoadFilter(path string) (*Filter, error) {
+	p, err := policy.LoadPolicyFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFilter(p), nil
+}
+
+// NewFilter creates a filter evaluating the policy
+func NewFilter(p *auditinternal.Policy) *Filter {
+	return &Filter{evaluator: policy.NewPolicyRuleEvaluator(p)}
+}
+
+// Process implements ingestion.Processor
+func (f *Filter) Process(entry *ingestion.Entry) bool {
+	return f.Apply(&entry.Event)
+}
+
+// Apply lowers the event to the level of its matching rule, removing the bodies
+// that level does not record. It returns false when the event is dropped.
+func (f *Filter) Apply(event *auditv1.Event) bool {
+	f.evaluated.Add(1)
+	config := f.evaluator.EvaluatePolicyRule(attributes(event))
+	if config.Level == auditinternal.LevelNone || slices.Contains(config.OmitStages, auditinternal.Stage(event.Stage)) {
+		f.dropped.Add(1)
+		return false
+	}
+
+	if config.Level.Less(auditinternal.Level(event.Level)) {
+		f.downgraded.Add(1)
+		event.Level = auditv1.Level(config.Level)
+	}
+	if event.Level != auditv1.LevelRequestResponse {
+		event.ResponseObject = nil
+	}
+	if event.Level == auditv1.LevelMetadata {
+		event.RequestObject = nil
+	}
+	if config.OmitManagedFields {
+		event.RequestObject = withoutManagedFields(event.RequestObject)
+		event.ResponseObject = withoutManagedFields(event.ResponseObject)
+	}
+	return true
+}
+
+// Stats returns a snapshot of the filter counters
+func (f *Filter) Stats() Stats {
+	return Stats{
+		Evaluated:  f.evaluated.Load(),
+		Dropped:    f.dropped.Load(),
+		Downgraded: f.downgraded.Load(),
+	}
+}
+
+// attributes describes the request of the event the way the apiserver evaluates its policy
+func attributes(event *auditv1.Event) authorizer.Attributes {
+	attrs := authorizer.AttributesRecord{
+		User: &user.DefaultInfo{Name: event.User.Username, Groups: event.User.Groups},
+		Verb: event.Verb,
+	}
+	if event.ObjectRef != nil {
+		attrs.ResourceRequest = true
+		attrs.Namespace = event.ObjectRef.Namespace
+		attrs.APIGroup = event.ObjectRef.APIGroup
+		attrs.APIVersion = event.ObjectRef.APIVersion
+		attrs.Resource = event.ObjectRef.Resource
+		attrs.Subresource = event.ObjectRef.Subresource
+		attrs.Name = event.ObjectRef.Name
+	} else if u, err := url.ParseRequestURI(event.RequestURI); err == nil {
+		attrs.Path = u.Path
+	} else {
+		attrs.Path = event.RequestURI
+	}
+	return attrs
+}
+
+// withoutManagedFields returns a copy of the object without metadata.managedFields,
+// or the object itself when it is not a JSON object
+func withoutManagedFields(object *runtime.Unknown) *runtime.Unknown {
+	if object == nil || len(object.Raw) == 0 {
+		return object
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(object.Raw, &fields); err != nil {
+		return object
+	}
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal(fields["metadata"], &metadata); err != nil {
+		return object
+	}
+	if _, ok := metadata["managedFields"]; !ok {
+		return object
+	}
+	delete(metadata, "managedFields")
+
+	var err error
+	if fields["metadata"], err = json.Marshal(metadata); err != nil {
+		return object
+	}
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return object
+	}
+	stripped := *object
+	stripped.Raw = raw
+	return &stripped
+}
@@ -0,0 +1,144 @@
+package auditfilter_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+const testPolicy = `
+apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages: [RequestReceived]
+rules:
+  # Lease renewals of the nodes and controllers
+  - level: None
+    userGroups: ["system:nodes"]
+    verbs: [update, patch]
+    resources:
+      - group: coordination.k8s.io
+        resources: [leases]
+  - level: None
+    nonResourceURLs: ["/healthz*", /readyz]
+  - level: Metadata
+    resources:
+      - group: ""
+        resources: [configmaps]
+  - level: Request
+    namespaces: [kube-system]
+  - level: RequestResponse
+    omitManagedFields: true
+`
+
+func newFilter(t *testing.T) *auditfilter.Filter {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0o600))
+	filter, err := auditfilter.LoadFilter(path)
+	require.NoError(t, err)
+	return filter
+}
+
+func newEvent(verb string, objectRef *auditv1.ObjectReference) auditv1.Event {
+	now := metav1.NewMicroTime(time.Now())
+	return auditv1.Event{
+		Level:                    auditv1.LevelRequestResponse,
+		AuditID:                  types.UID("id"),
+		Stage:                    auditv1.StageResponseComplete,
+		RequestURI:               "/api/v1/namespaces/default/configmaps/settings",
+		Verb:                     verb,
+		User:                     authnv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}},
+		UserAgent:                "kubectl/v1.30.0",
+		ObjectRef:                objectRef,
+		RequestObject:            &runtime.Unknown{Raw: []byte(`{"kind":"ConfigMap","metadata":{"name":"settings","managedFields":[{"manager":"kubectl"}]}}`)},
+		ResponseObject:           &runtime.Unknown{Raw: []byte(`{"kind":"ConfigMap","metadata":{"name":"settings","managedFields":[{"manager":"kubectl"}]}}`)},
+		RequestReceivedTimestamp: now,
+		StageTimestamp:           now,
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Run("should drop lease renewals of nodes only", func(t *testing.T) {
+		filter := newFilter(t)
+		lease := &auditv1.ObjectReference{Resource: "leases", APIGroup: "coordination.k8s.io", Namespace: "kube-node-lease", Name: "node-1"}
+
+		renewal := newEvent("update", lease)
+		renewal.User = authnv1.UserInfo{Username: "system:node:node-1", Groups: []string{"system:nodes"}}
+		assert.False(t, filter.Apply(&renewal))
+
+		manual := newEvent("update", lease)
+		assert.True(t, filter.Apply(&manual))
+	})
+
+	t.Run("should match non-resource URLs without the query", func(t *testing.T) {
+		filter := newFilter(t)
+		probe := newEvent("get", nil)
+		probe.RequestURI = "/healthz/etcd?verbose=true"
+		assert.False(t, filter.Apply(&probe))
+
+		version := newEvent("get", nil)
+		version.RequestURI = "/version"
+		assert.True(t, filter.Apply(&version))
+	})
+
+	t.Run("should strip the bodies of downgraded events", func(t *testing.T) {
+		filter := newFilter(t)
+		configMap := newEvent("update", &auditv1.ObjectReference{Resource: "configmaps", Namespace: "default", Name: "settings"})
+		require.True(t, filter.Apply(&configMap))
+		assert.Equal(t, auditv1.LevelMetadata, configMap.Level)
+		assert.Nil(t, configMap.RequestObject)
+		assert.Nil(t, configMap.ResponseObject)
+
+		system := newEvent("update", &auditv1.ObjectReference{Resource: "secrets", Namespace: "kube-system", Name: "token"})
+		require.True(t, filter.Apply(&system))
+		assert.Equal(t, auditv1.LevelRequest, system.Level)
+		assert.NotNil(t, system.RequestObject)
+		assert.Nil(t, system.ResponseObject)
+
+		assert.Equal(t, auditfilter.Stats{Evaluated: 2, Downgraded: 2}, filter.Stats())
+	})
+
+	t.Run("should never raise the level recorded by the apiserver", func(t *testing.T) {
+		filter := newFilter(t)
+		event := newEvent("create", &auditv1.ObjectReference{Resource: "pods", Namespace: "default"})
+		event.Level = auditv1.LevelMetadata
+		event.RequestObject, event.ResponseObject = nil, nil
+		require.True(t, filter.Apply(&event))
+		assert.Equal(t, auditv1.LevelMetadata, event.Level)
+	})
+
+	t.Run("should drop omitted stages and managed fields", func(t *testing.T) {
+		filter := newFilter(t)
+		received := newEvent("create", &auditv1.ObjectReference{Resource: "pods", Namespace: "default"})
+		received.Stage = auditv1.StageRequestReceived
+		assert.False(t, filter.Apply(&received))
+
+		original := newEvent("create", &auditv1.ObjectReference{Resource: "pods", Namespace: "default"})
+		event := original
+		require.True(t, filter.Apply(&event))
+		assert.JSONEq(t, `{"kind":"ConfigMap","metadata":{"name":"settings"}}`, string(event.RequestObject.Raw))
+		assert.JSONEq(t, `{"kind":"ConfigMap","metadata":{"name":"settings"}}`, string(event.ResponseObject.Raw))
+		assert.Contains(t, string(original.RequestObject.Raw), "managedFields")
+	})
+
+	t.Run("should report dropped events in the ingestion result", func(t *testing.T) {
+		service := ingestion.NewService(nil, ingestion.WithProcessors(newFilter(t)))
+		renewal := newEvent("patch", &auditv1.ObjectReference{Resource: "leases", APIGroup: "coordination.k8s.io"})
+		renewal.User.Groups = []string{"system:nodes"}
+
+		accepted, result := service.Prepare("", []auditv1.Event{renewal, newEvent("create", &auditv1.ObjectReference{Resource: "pods"})})
+		assert.Len(t, accepted, 1)
+		assert.Equal(t, 1, result.Dropped)
+		assert.Equal(t, 1, result.Accepted)
+	})
+}
@@ -0,0 +1,14 @@
+package auditfilter
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewStatsHandler returns a gin handler exposing the filter statistics as JSON
+func NewStatsHandler(filter *Filter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, filter.Stats())
+	}
+}
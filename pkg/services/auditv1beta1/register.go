@@ -0,0 +1,63 @@
+package auditv1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// SchemeGroupVersion is the group version of the types of this package
+var SchemeGroupVersion = schema.GroupVersion{Group: auditv1.GroupName, Version: "v1beta1"}
+
+// AddToScheme registers the v1beta1 types and their conversion into audit.k8s.io/v1
+func AddToScheme(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion, &Event{}, &EventList{})
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	if err := scheme.AddConversionFunc((*Event)(nil), (*auditv1.Event)(nil), func(a, b any, _ conversion.Scope) error {
+		ConvertToV1(a.(*Event), b.(*auditv1.Event))
+		return nil
+	}); err != nil {
+		return err
+	}
+	return scheme.AddConversionFunc((*EventList)(nil), (*auditv1.EventList)(nil), func(a, b any, _ conversion.Scope) error {
+		in, out := a.(*EventList), b.(*auditv1.EventList)
+		out.ListMeta = in.ListMeta
+		out.Items = make([]auditv1.Event, len(in.Items))
+		for i := range in.Items {
+			ConvertToV1(&in.Items[i], &out.Items[i])
+		}
+		return nil
+	})
+}
+
+// ConvertToV1 converts a v1beta1 event into out. Events written before the request
+// and stage timestamps existed get Timestamp as both.
+func ConvertToV1(in *Event, out *auditv1.Event) {
+	*out = auditv1.Event{
+		TypeMeta:                 metav1.TypeMeta{APIVersion: auditv1.SchemeGroupVersion.String(), Kind: "Event"},
+		Level:                    in.Level,
+		AuditID:                  in.AuditID,
+		Stage:                    in.Stage,
+		RequestURI:               in.RequestURI,
+		Verb:                     in.Verb,
+		User:                     in.User,
+		ImpersonatedUser:         in.ImpersonatedUser,
+		SourceIPs:                in.SourceIPs,
+		UserAgent:                in.UserAgent,
+		ObjectRef:                in.ObjectRef,
+		ResponseStatus:           in.ResponseStatus,
+		RequestObject:            in.RequestObject,
+		ResponseObject:           in.ResponseObject,
+		RequestReceivedTimestamp: in.RequestReceivedTimestamp,
+		StageTimestamp:           in.StageTimestamp,
+		Annotations:              in.Annotations,
+	}
+	if out.RequestReceivedTimestamp.IsZero() && !in.Timestamp.IsZero() {
+		out.RequestReceivedTimestamp = metav1.NewMicroTime(in.Timestamp.Time)
+	}
+	if out.StageTimestamp.IsZero() && !in.Timestamp.IsZero() {
+		out.StageTimestamp = metav1.NewMicroTime(in.Timestamp.Time)
+	}
+}
@@ -0,0 +1,106 @@
+package auditv1beta1
+
+import (
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// Event is an audit.k8s.io/v1beta1 event, written by apiservers before 1.24 when
+// the audit backends were configured with that version, and dropped from
+// k8s.io/apiserver since. Its fields are those of v1 plus Timestamp, the only
+// time of events written by the apiservers that predate the request and stage
+// timestamps.
+type Event struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Level auditv1.Level `json:"level"`
+	// Timestamp is when the event was created, deprecated in favor of StageTimestamp
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	AuditID    types.UID     `json:"auditID"`
+	Stage      auditv1.Stage `json:"stage"`
+	RequestURI string        `json:"requestURI"`
+	Verb       string        `json:"verb"`
+
+	User             authnv1.UserInfo  `json:"user"`
+	ImpersonatedUser *authnv1.UserInfo `json:"impersonatedUser,omitempty"`
+	SourceIPs        []string          `json:"sourceIPs,omitempty"`
+	UserAgent        string            `json:"userAgent,omitempty"`
+
+	ObjectRef      *auditv1.ObjectReference `json:"objectRef,omitempty"`
+	ResponseStatus *metav1.Status           `json:"responseStatus,omitempty"`
+	RequestObject  *runtime.Unknown         `json:"requestObject,omitempty"`
+	ResponseObject *runtime.Unknown         `json:"responseObject,omitempty"`
+
+	RequestReceivedTimestamp metav1.MicroTime `json:"requestReceivedTimestamp,omitempty"`
+	StageTimestamp           metav1.MicroTime `json:"stageTimestamp,omitempty"`
+
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// EventList is an audit.k8s.io/v1beta1 list of events
+type EventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Event `json:"items"`
+}
+
+// DeepCopyInto copies the event into out
+func (in *Event) DeepCopyInto(out *Event) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	in.User.DeepCopyInto(&out.User)
+	if in.ImpersonatedUser != nil {
+		out.ImpersonatedUser = in.ImpersonatedUser.DeepCopy()
+	}
+	if in.SourceIPs != nil {
+		out.SourceIPs = append([]string(nil), in.SourceIPs...)
+	}
+	if in.ObjectRef != nil {
+		out.ObjectRef = in.ObjectRef.DeepCopy()
+	}
+	if in.ResponseStatus != nil {
+		out.ResponseStatus = in.ResponseStatus.DeepCopy()
+	}
+	if in.RequestObject != nil {
+		out.RequestObject = in.RequestObject.DeepCopy()
+	}
+	if in.ResponseObject != nil {
+		out.ResponseObject = in.ResponseObject.DeepCopy()
+	}
+	in.RequestReceivedTimestamp.DeepCopyInto(&out.RequestReceivedTimestamp)
+	in.StageTimestamp.DeepCopyInto(&out.StageTimestamp)
+	if in.Annotations != nil {
+		out.Annotations = make(map[string]string, len(in.Annotations))
+		for key, value := range in.Annotations {
+			out.Annotations[key] = value
+		}
+	}
+}
+
+// DeepCopyObject implements runtime.Object
+func (in *Event) DeepCopyObject() runtime.Object {
+	out := &Event{}
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object
+func (in *EventList) DeepCopyObject() runtime.Object {
+	out := &EventList{TypeMeta: in.TypeMeta}
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]Event, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+	return out
+}
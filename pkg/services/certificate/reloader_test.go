@@ -0,0 +1,120 @@
+package certificate_test
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/certificate"
+)
+
+func writeSelfSigned(t *testing.T, certFile, keyFile string, host string, modTime time.Time) {
+	certPEM, keyPEM, err := certificate.GenerateSelfSigned([]string{host}, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func servedDNSNames(t *testing.T, reloader *certificate.Reloader) []string {
+	served, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(served.Certificate[0])
+	require.NoError(t, err)
+	return leaf.DNSNames
+}
+
+func TestReloader(t *testing.T) {
+	t.Run("should serve the new certificate after the files changed", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+		writeSelfSigned(t, certFile, keyFile, "old.example.com", time.Now().Add(-time.Minute))
+
+		reloader, err := certificate.NewReloader(certFile, keyFile)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"old.example.com"}, servedDNSNames(t, reloader))
+
+		reloaded, err := reloader.Reload()
+		require.NoError(t, err)
+		assert.False(t, reloaded)
+
+		writeSelfSigned(t, certFile, keyFile, "new.example.com", time.Now())
+		reloaded, err = reloader.Reload()
+		require.NoError(t, err)
+		assert.True(t, reloaded)
+		assert.Equal(t, []string{"new.example.com"}, servedDNSNames(t, reloader))
+	})
+
+	t.Run("should keep the previous certificate when the new files are broken", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+		writeSelfSigned(t, certFile, keyFile, "old.example.com", time.Now().Add(-time.Minute))
+
+		reloader, err := certificate.NewReloader(certFile, keyFile)
+		require.NoError(t, err)
+
+		require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o600))
+		_, err = reloader.Reload()
+		assert.Error(t, err)
+		assert.Equal(t, []string{"old.example.com"}, servedDNSNames(t, reloader))
+	})
+}
+
+func TestEnsureSelfSigned(t *testing.T) {
+	t.Run("should generate the files once", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "certs", "tls.crt"), filepath.Join(dir, "certs", "tls.key")
+
+		generated, err := certificate.EnsureSelfSigned(certFile, keyFile, []string{"localhost", "127.0.0.1"})
+		require.NoError(t, err)
+		assert.True(t, generated)
+		first, err := os.ReadFile(certFile)
+		require.NoError(t, err)
+
+		generated, err = certificate.EnsureSelfSigned(certFile, keyFile, []string{"localhost"})
+		require.NoError(t, err)
+		assert.False(t, generated)
+		second, err := os.ReadFile(certFile)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+
+		reloader, err := certificate.NewReloader(certFile, keyFile)
+		require.NoError(t, err)
+		served, err := reloader.GetCertificate(nil)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(served.Certificate[0])
+		require.NoError(t, err)
+		assert.Equal(t, []string{"localhost"}, leaf.DNSNames)
+		assert.Len(t, leaf.IPAddresses, 1)
+	})
+
+	t.Run("should refuse to overwrite a lone certificate or key", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+		require.NoError(t, os.WriteFile(keyFile, []byte("existing key"), 0o600))
+
+		_, err := certificate.EnsureSelfSigned(certFile, keyFile, []string{"localhost"})
+		assert.Error(t, err)
+		assert.NoFileExists(t, certFile)
+		key, err := os.ReadFile(keyFile)
+		require.NoError(t, err)
+		assert.Equal(t, "existing key", string(key))
+	})
+
+	t.Run("should trim the hosts of a comma separated list", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+		_, err := certificate.EnsureSelfSigned(certFile, keyFile, strings.Split("localhost, kad.example.com ,127.0.0.1,", ","))
+		require.NoError(t, err)
+
+		reloader, err := certificate.NewReloader(certFile, keyFile)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"localhost", "kad.example.com"}, servedDNSNames(t, reloader))
+	})
+}
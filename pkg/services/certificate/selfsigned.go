@@ -0,0 +1,105 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SelfSignedValidity is how long generated development certificates are valid
+const SelfSignedValidity = 365 * 24 * time.Hour
+
+// EnsureSelfSigned writes a self-signed certificate for the hosts to certFile and
+// keyFile unless both already exist, reporting whether it generated them. It
+// fails when only one of them exists, rather than overwriting it. The certificate is meant for development; configure the webhook kubeconfig
+// with it as certificate-authority to trust it.
+func EnsureSelfSigned(certFile, keyFile string, hosts []string) (bool, error) {
+	_, certErr := os.Stat(certFile)
+	_, keyErr := os.Stat(keyFile)
+	if certErr == nil && keyErr == nil {
+		return false, nil
+	}
+	if certErr == nil || keyErr == nil {
+		return false, fmt.Errorf("only one of %s and %s exists, remove it or provide the other one", certFile, keyFile)
+	}
+	if !errors.Is(certErr, os.ErrNotExist) && certErr != nil {
+		return false, certErr
+	}
+	if !errors.Is(keyErr, os.ErrNotExist) && keyErr != nil {
+		return false, keyErr
+	}
+
+	certPEM, keyPEM, err := GenerateSelfSigned(hosts, SelfSignedValidity)
+	if err != nil {
+		return false, err
+	}
+	for path, data := range map[string][]byte{certFile: certPEM, keyFile: keyPEM} {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return false, err
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// GenerateSelfSigned returns a PEM encoded self-signed certificate and key valid for
+// the hosts, which may be DNS names or IP addresses. Surrounding spaces are
+// trimmed and empty hosts ignored, as in comma separated lists.
+func GenerateSelfSigned(hosts []string, validity time.Duration) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "kubernetes-auditing-dashboard"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
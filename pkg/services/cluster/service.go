@@ -0,0 +1,59 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+)
+
+// Summary describes the audit events stored for one cluster
+type Summary struct {
+	Name       string
+	EventCount int
+	// EstimatedEventCount is the sum of the sample weights, the number of events received before sampling
+	EstimatedEventCount int
+	// LastSeen is the request timestamp of the most recent event, nil when unknown
+	LastSeen *time.Time
+}
+
+// ListClusters returns every cluster with stored audit events, ordered by name
+func ListClusters(ctx context.Context, client *ent.Client) ([]*Summary, error) {
+	var groups []struct {
+		Cluster string `json:"cluster"`
+		Count   int    `json:"count"`
+		Sum     int    `json:"sum"`
+	}
+	err := client.AuditEvent.Query().
+		GroupBy(auditevent.FieldCluster).
+		Aggregate(ent.Count(), ent.Sum(auditevent.FieldSampleWeight)).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*Summary, 0, len(groups))
+	for _, group := range groups {
+		summary := &Summary{Name: group.Cluster, EventCount: group.Count, EstimatedEventCount: group.Sum}
+
+		// Served by the (cluster, requestTimestamp) index
+		latest, err := client.AuditEvent.Query().
+			Where(auditevent.ClusterEQ(group.Cluster)).
+			Order(ent.Desc(auditevent.FieldRequestTimestamp)).
+			Select(auditevent.FieldRequestTimestamp).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if latest != nil {
+			lastSeen := latest.RequestTimestamp
+			summary.LastSeen = &lastSeen
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Name < summaries[j].Name })
+	return summaries, nil
+}
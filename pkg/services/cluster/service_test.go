@@ -0,0 +1,91 @@
+package cluster_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/cluster"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	dbName := fmt.Sprintf("file:cluster_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63())
+	client := enttest.Open(t, "sqlite3", dbName)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func createEvent(t *testing.T, client *ent.Client, auditID, clusterName string, requestTime time.Time) {
+	_, err := client.AuditEvent.Create().
+		SetRaw("{}").
+		SetLevel("RequestResponse").
+		SetAuditID(auditID).
+		SetVerb("create").
+		SetUserAgent("kubectl/v1.30.0").
+		SetRequestTimestamp(requestTime).
+		SetStageTimestamp(requestTime).
+		SetStage("ResponseComplete").
+		SetCluster(clusterName).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func TestListClusters(t *testing.T) {
+	t.Run("should summarize events per cluster", func(t *testing.T) {
+		client := setupTestDB(t)
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		createEvent(t, client, "a", "prod", base)
+		createEvent(t, client, "b", "prod", base.Add(time.Hour))
+		createEvent(t, client, "c", "dev", base.Add(2*time.Hour))
+
+		summaries, err := cluster.ListClusters(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, summaries, 2)
+
+		assert.Equal(t, "dev", summaries[0].Name)
+		assert.Equal(t, 1, summaries[0].EventCount)
+		assert.Equal(t, "prod", summaries[1].Name)
+		assert.Equal(t, 2, summaries[1].EventCount)
+		require.NotNil(t, summaries[1].LastSeen)
+		assert.True(t, base.Add(time.Hour).Equal(*summaries[1].LastSeen))
+	})
+
+	t.Run("should count the events dropped by sampling through the sample weights", func(t *testing.T) {
+		client := setupTestDB(t)
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		createEvent(t, client, "a", "prod", base)
+		_, err := client.AuditEvent.Create().
+			SetRaw("{}").
+			SetLevel("Metadata").
+			SetAuditID("b").
+			SetVerb("list").
+			SetUserAgent("kubectl/v1.30.0").
+			SetRequestTimestamp(base).
+			SetStageTimestamp(base).
+			SetStage("ResponseComplete").
+			SetCluster("prod").
+			SetSampleWeight(10).
+			Save(context.Background())
+		require.NoError(t, err)
+
+		summaries, err := cluster.ListClusters(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, summaries, 1)
+		assert.Equal(t, 2, summaries[0].EventCount)
+		assert.Equal(t, 11, summaries[0].EstimatedEventCount)
+	})
+
+	t.Run("should return no clusters for an empty database", func(t *testing.T) {
+		summaries, err := cluster.ListClusters(context.Background(), setupTestDB(t))
+		require.NoError(t, err)
+		assert.Empty(t, summaries)
+	})
+}
@@ -0,0 +1,542 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/strrl/kubernetes-auditing-dashboard/gql"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/certificate"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/forwarding"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/redaction"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// EnvPrefix prefixes the environment variables overriding the configuration.
+// The variable of a setting is its flag name in upper case with dashes replaced
+// by underscores, e.g. KAD_INGEST_WORKERS for -ingest-workers.
+const EnvPrefix = "KAD_"
+
+// EnvConfigFile names the configuration file when -config is not given
+const EnvConfigFile = EnvPrefix + "CONFIG"
+
+// DefaultShutdownTimeout is the time given to the graceful shutdown of serve
+const DefaultShutdownTimeout = 30 * time.Second
+
+// Config is the configuration of the dashboard. It is read from a YAML file,
+// then overridden by environment variables, then by command line flags.
+type Config struct {
+	Listen         ListenConfig         `json:"listen"`
+	TLS            TLSConfig            `json:"tls"`
+	Database       DatabaseConfig       `json:"database"`
+	Auth           AuthConfig           `json:"auth"`
+	Ingestion      IngestionConfig      `json:"ingestion"`
+	RawCompression RawCompressionConfig `json:"rawCompression"`
+	RawEncryption  RawEncryptionConfig  `json:"rawEncryption"`
+	Retention      RetentionConfig      `json:"retention"`
+	Forwarding     ForwardingConfig     `json:"forwarding"`
+	GraphQL        GraphQLConfig        `json:"graphql"`
+}
+
+// ListenConfig sets the addresses the server listens on
+type ListenConfig struct {
+	// Address serves the dashboard, and the webhook unless WebhookAddress is set
+	Address string `json:"address"`
+	// WebhookAddress is a separate address serving only the audit webhook
+	WebhookAddress string `json:"webhookAddress,omitempty"`
+	// ShutdownTimeout bounds the graceful shutdown: finishing in-flight requests and writing buffered events
+	ShutdownTimeout metav1.Duration `json:"shutdownTimeout"`
+}
+
+// TLSConfig configures HTTPS serving
+type TLSConfig struct {
+	CertFile        string          `json:"certFile,omitempty"`
+	KeyFile         string          `json:"keyFile,omitempty"`
+	SelfSigned      bool            `json:"selfSigned,omitempty"`
+	SelfSignedHosts string          `json:"selfSignedHosts"`
+	ReloadInterval  metav1.Duration `json:"reloadInterval"`
+}
+
+// DatabaseConfig selects the database
+type DatabaseConfig struct {
+	DSN string `json:"dsn"`
+}
+
+// AuthConfig lists the files authenticating webhook requests
+type AuthConfig struct {
+	WebhookCredentials string `json:"webhookCredentials,omitempty"`
+	WebhookClientCA    string `json:"webhookClientCA,omitempty"`
+	// WebhookAllowAnonymous accepts unauthenticated webhook requests when no credential is configured
+	WebhookAllowAnonymous bool `json:"webhookAllowAnonymous,omitempty"`
+}
+
+// IngestionConfig tunes how webhook events are buffered and written
+type IngestionConfig struct {
+	QueueSize      int             `json:"queueSize"`
+	BatchSize      int             `json:"batchSize"`
+	FlushInterval  metav1.Duration `json:"flushInterval"`
+	Workers        int             `json:"workers"`
+	DefaultCluster string          `json:"defaultCluster"`
+	// MaxBodySize is the largest webhook body accepted, in bytes, before and after decompression
+	MaxBodySize int64 `json:"maxBodySize"`
+	// FilterPolicy is an audit.k8s.io/v1 Policy file dropping or downgrading received events
+	FilterPolicy string `json:"filterPolicy,omitempty"`
+	// Sampling keeps a fraction of the reads per user and resource
+	Sampling sampling.Config `json:"sampling"`
+	// Redaction hashes sensitive fields of the bodies before they are stored
+	Redaction RedactionConfig `json:"redaction"`
+	// DeadLettersDisabled discards the webhook payloads that cannot be ingested instead of keeping them
+	DeadLettersDisabled bool `json:"deadLettersDisabled,omitempty"`
+}
+
+// RedactionConfig configures the redaction of Secrets, tokens and the fields listed by Rules
+type RedactionConfig struct {
+	Disabled bool `json:"disabled,omitempty"`
+	// KeyFile holds the HMAC key of the redacted values, generated when missing
+	KeyFile string           `json:"keyFile"`
+	Rules   []redaction.Rule `json:"rules,omitempty"`
+}
+
+// RawCompressionConfig configures how raw payloads are compressed
+type RawCompressionConfig struct {
+	Disabled   bool   `json:"disabled,omitempty"`
+	Level      string `json:"level"`
+	Dictionary string `json:"dictionary,omitempty"`
+}
+
+// RawEncryptionConfig configures the encryption of raw payloads at rest
+type RawEncryptionConfig struct {
+	// Keyring is a YAML file of AES-256 keys, encryption is disabled when empty
+	Keyring string `json:"keyring,omitempty"`
+}
+
+// RetentionConfig is the retention policy and the schedule of the background pruner.
+// The policy is given inline or by PolicyFile, which replaces the inline rules.
+type RetentionConfig struct {
+	PolicyFile string `json:"policyFile,omitempty"`
+	retention.Policy
+	PruneInterval  metav1.Duration `json:"pruneInterval"`
+	PruneBatchSize int             `json:"pruneBatchSize"`
+	VacuumInterval metav1.Duration `json:"vacuumInterval"`
+}
+
+// ForwardingConfig lists the downstream webhooks the ingested events are forwarded to
+type ForwardingConfig struct {
+	// QueueDir holds one directory per sink with the events not delivered yet
+	QueueDir string                  `json:"queueDir"`
+	Sinks    []forwarding.SinkConfig `json:"sinks,omitempty"`
+}
+
+// GraphQLConfig configures the GraphQL API
+type GraphQLConfig struct {
+	DefaultPageSize int `json:"defaultPageSize"`
+}
+
+// Default returns the configuration used when nothing is configured
+func Default() *Config {
+	queue := ingestion.DefaultQueueOptions()
+	pruner := retention.DefaultOptions()
+	return &Config{
+		Listen: ListenConfig{Address: "0.0.0.0:23333", ShutdownTimeout: metav1.Duration{Duration: DefaultShutdownTimeout}},
+		TLS: TLSConfig{
+			SelfSignedHosts: "localhost,127.0.0.1",
+			ReloadInterval:  metav1.Duration{Duration: certificate.DefaultReloadInterval},
+		},
+		Database: DatabaseConfig{DSN: database.DefaultDSN},
+		Ingestion: IngestionConfig{
+			QueueSize:      queue.Capacity,
+			BatchSize:      queue.BatchSize,
+			FlushInterval:  metav1.Duration{Duration: queue.FlushInterval},
+			Workers:        queue.Workers,
+			DefaultCluster: ingestion.DefaultCluster,
+			MaxBodySize:    ingestion.DefaultMaxBodySize,
+			Redaction:      RedactionConfig{KeyFile: "redaction.key"},
+		},
+		RawCompression: RawCompressionConfig{Level: "default"},
+		Retention: RetentionConfig{
+			PruneInterval:  metav1.Duration{Duration: pruner.Interval},
+			PruneBatchSize: pruner.BatchSize,
+			VacuumInterval: metav1.Duration{Duration: pruner.VacuumInterval},
+		},
+		Forwarding: ForwardingConfig{QueueDir: forwarding.DefaultQueueDir},
+		GraphQL:    GraphQLConfig{DefaultPageSize: gql.DefaultPageSize},
+	}
+}
+
+// Load reads the YAML configuration file over the defaults, an empty path returns the defaults
+func Load(path string) (*Config, error) {
+	c := Default()
+	if path == "" {
+		return c, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// PathFromArgs returns the configuration file given by -config in the arguments,
+// or by the KAD_CONFIG environment variable. The file is needed before the
+// flags are parsed because it provides their defaults.
+func PathFromArgs(args []string, lookupEnv func(string) (string, bool)) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || name != "config" {
+			continue
+		}
+		if hasValue {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	path, _ := lookupEnv(EnvConfigFile)
+	return path
+}
+
+// AddFlags registers the flags of every setting, defaulting to the current values
+func (c *Config) AddFlags(flags *flag.FlagSet) {
+	c.Listen.AddFlags(flags)
+	c.TLS.AddFlags(flags)
+	c.Database.AddFlags(flags)
+	c.Auth.AddFlags(flags)
+	c.Ingestion.AddFlags(flags)
+	c.RawCompression.AddFlags(flags)
+	c.RawEncryption.AddFlags(flags)
+	c.Retention.AddFlags(flags)
+	c.Forwarding.AddFlags(flags)
+	c.GraphQL.AddFlags(flags)
+}
+
+// AddFlags registers the listen flags
+func (c *ListenConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.Address, "listen", c.Address, "address serving the dashboard, and the webhook unless -webhook-listen is set")
+	flags.StringVar(&c.WebhookAddress, "webhook-listen", c.WebhookAddress, "separate address serving only the audit webhook")
+	flags.DurationVar(&c.ShutdownTimeout.Duration, "shutdown-timeout", c.ShutdownTimeout.Duration, "time given on SIGTERM to finish in-flight requests and write buffered audit events")
+}
+
+// AddFlags registers the TLS flags
+func (c *TLSConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.CertFile, "tls-cert-file", c.CertFile, "certificate served over HTTPS, plain HTTP when empty")
+	flags.StringVar(&c.KeyFile, "tls-key-file", c.KeyFile, "private key of the served certificate")
+	flags.BoolVar(&c.SelfSigned, "tls-self-signed", c.SelfSigned, "generate a self-signed development certificate at -tls-cert-file and -tls-key-file when they do not exist")
+	flags.StringVar(&c.SelfSignedHosts, "tls-self-signed-hosts", c.SelfSignedHosts, "comma separated DNS names and IPs of the self-signed certificate")
+	flags.DurationVar(&c.ReloadInterval.Duration, "tls-reload-interval", c.ReloadInterval.Duration, "how often the certificate files are checked for changes")
+}
+
+// AddFlags registers the database flag
+func (c *DatabaseConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.DSN, "database", c.DSN, "database DSN: postgres://, mysql://, sqlite:// or a plain SQLite DSN")
+}
+
+// AddFlags registers the webhook authentication flags
+func (c *AuthConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.WebhookCredentials, "webhook-credentials", c.WebhookCredentials, "YAML file listing the bearer tokens and client certificates accepted by the webhook and their clusters")
+	flags.StringVar(&c.WebhookClientCA, "webhook-client-ca", c.WebhookClientCA, "PEM bundle of the CAs signing webhook client certificates, requires TLS")
+	flags.BoolVar(&c.WebhookAllowAnonymous, "webhook-allow-anonymous", c.WebhookAllowAnonymous, "accept unauthenticated webhook requests when no credential is configured, for development only")
+}
+
+// AddFlags registers the ingestion tuning flags
+func (c *IngestionConfig) AddFlags(flags *flag.FlagSet) {
+	flags.IntVar(&c.QueueSize, "ingest-queue-size", c.QueueSize, "maximum number of audit events buffered before the webhook answers 429, at least 500")
+	flags.IntVar(&c.BatchSize, "ingest-batch-size", c.BatchSize, "maximum number of audit events written in one batch")
+	flags.DurationVar(&c.FlushInterval.Duration, "ingest-flush-interval", c.FlushInterval.Duration, "maximum time an audit event waits before its batch is written")
+	flags.IntVar(&c.Workers, "ingest-workers", c.Workers, "number of concurrent database writers, 0 writes synchronously inside the webhook request")
+	flags.StringVar(&c.DefaultCluster, "default-cluster", c.DefaultCluster, "cluster of audit events received without cluster attribution")
+	flags.Int64Var(&c.MaxBodySize, "webhook-max-body-size", c.MaxBodySize, "largest webhook body accepted in bytes, compressed or not, larger bodies are answered 413")
+	flags.BoolVar(&c.DeadLettersDisabled, "dead-letters-disabled", c.DeadLettersDisabled, "discard webhook bodies that cannot be decoded and rejected events instead of keeping them to be reprocessed")
+	c.AddProcessingFlags(flags)
+}
+
+// AddProcessingFlags registers the ingest filter, sampling and redaction flags, shared by every command ingesting events
+func (c *IngestionConfig) AddProcessingFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.FilterPolicy, "ingest-filter-policy", c.FilterPolicy, "audit.k8s.io/v1 Policy file evaluated on received events to drop them or lower their level; events matching no rule are dropped")
+	flags.IntVar(&c.Sampling.OneIn, "sample-reads-one-in", c.Sampling.OneIn, "store the first get, list and watch event per user and resource, then one in N picked by audit ID, with a sample weight of N")
+	flags.IntVar(&c.Sampling.PerMinute, "sample-reads-per-minute", c.Sampling.PerMinute, "store at most N get, list and watch events per user, resource and minute, weighted by the events dropped")
+	flags.BoolVar(&c.Redaction.Disabled, "redaction-disabled", c.Redaction.Disabled, "store Secret data, tokens and the configured fields without hashing them")
+	flags.StringVar(&c.Redaction.KeyFile, "redaction-key-file", c.Redaction.KeyFile, "file holding the HMAC key of redacted values, generated when missing")
+}
+
+// AddFlags registers the raw compression flags
+func (c *RawCompressionConfig) AddFlags(flags *flag.FlagSet) {
+	flags.BoolVar(&c.Disabled, "raw-compression-disabled", c.Disabled, "store raw audit payloads uncompressed")
+	flags.StringVar(&c.Level, "raw-compression-level", c.Level, "zstd level of raw audit payloads: fastest, default, better or best")
+	flags.StringVar(&c.Dictionary, "raw-dictionary", c.Dictionary, "zstd dictionary of raw audit payloads, as written by compress-raw -train-dictionary; needed to read payloads compressed with it")
+}
+
+// AddFlags registers the raw encryption flag
+func (c *RawEncryptionConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.Keyring, "raw-encryption-keyring", c.Keyring, "YAML keyring encrypting raw audit payloads with its primary key, as written by rotate-key -generate; needed to read payloads encrypted with its keys")
+}
+
+// AddPolicyFlags registers the retention policy flags
+func (c *RetentionConfig) AddPolicyFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.PolicyFile, "retention-policy", c.PolicyFile, "YAML retention policy with a default TTL and per verb, resource and namespace rules")
+	flags.Var(&c.DefaultTTL, "retention-default-ttl", "retention of events matching no rule, such as 720h or 30d, overrides the policy file; 0 keeps them forever")
+}
+
+// AddFlags registers the retention policy and pruner flags
+func (c *RetentionConfig) AddFlags(flags *flag.FlagSet) {
+	c.AddPolicyFlags(flags)
+	flags.DurationVar(&c.PruneInterval.Duration, "prune-interval", c.PruneInterval.Duration, "how often events expired by the retention policy are deleted")
+	flags.IntVar(&c.PruneBatchSize, "prune-batch-size", c.PruneBatchSize, "number of expired events deleted per transaction")
+	flags.DurationVar(&c.VacuumInterval.Duration, "vacuum-interval", c.VacuumInterval.Duration, "minimum time between two SQLite VACUUMs after pruning, 0 disables them")
+}
+
+// AddFlags registers the GraphQL flags
+func (c *GraphQLConfig) AddFlags(flags *flag.FlagSet) {
+	flags.IntVar(&c.DefaultPageSize, "default-page-size", c.DefaultPageSize, "number of audit events per page when the query does not ask for one")
+}
+
+// ApplyEnv overrides the settings with the environment variables named after their flags
+func (c *Config) ApplyEnv(lookupEnv func(string) (string, bool)) error {
+	flags := flag.NewFlagSet("env", flag.ContinueOnError)
+	c.AddFlags(flags)
+	var errs []error
+	flags.VisitAll(func(f *flag.Flag) {
+		name := EnvName(f.Name)
+		if value, ok := lookupEnv(name); ok {
+			if err := f.Value.Set(value); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s: %w", name, err))
+			}
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// EnvName returns the environment variable overriding the flag
+func EnvName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// Validate checks the settings, completing the self-signed certificate paths.
+// The files the configuration refers to are read later, by the commands using them.
+func (c *Config) Validate() error {
+	var errs []error
+	if err := validateAddress("listen", c.Listen.Address); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Listen.WebhookAddress != "" {
+		if err := validateAddress("webhook-listen", c.Listen.WebhookAddress); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := c.TLS.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Auth.WebhookClientCA != "" && !c.TLS.Enabled() {
+		errs = append(errs, errors.New("webhook-client-ca requires tls-cert-file and tls-key-file"))
+	}
+	if err := c.Database.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Ingestion.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.RawCompression.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Retention.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Forwarding.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Listen.ShutdownTimeout.Duration <= 0 {
+		errs = append(errs, errors.New("shutdown-timeout must be positive"))
+	}
+	if c.GraphQL.DefaultPageSize <= 0 {
+		errs = append(errs, errors.New("default-page-size must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+func validateAddress(name, addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("invalid %s address %q: %w", name, addr, err)
+	}
+	return nil
+}
+
+// Enabled reports whether HTTPS is served
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// Validate checks that the certificate and key are set together, defaulting
+// them to tls.crt and tls.key for a self-signed certificate
+func (c *TLSConfig) Validate() error {
+	if c.SelfSigned && c.CertFile == "" && c.KeyFile == "" {
+		c.CertFile, c.KeyFile = "tls.crt", "tls.key"
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("tls-cert-file and tls-key-file must be set together")
+	}
+	return nil
+}
+
+// Validate checks that the DSN names a supported database
+func (c *DatabaseConfig) Validate() error {
+	_, err := database.ParseDSN(c.DSN)
+	return err
+}
+
+// Validate checks the queue sizes and the default cluster name
+func (c *IngestionConfig) Validate() error {
+	var errs []error
+	if c.QueueSize < ingestion.WebhookChunkSize {
+		errs = append(errs, fmt.Errorf("ingest-queue-size must be at least %d, the number of events the webhook submits at once", ingestion.WebhookChunkSize))
+	}
+	if c.BatchSize <= 0 {
+		errs = append(errs, errors.New("ingest-batch-size must be positive"))
+	}
+	if c.FlushInterval.Duration <= 0 {
+		errs = append(errs, errors.New("ingest-flush-interval must be positive"))
+	}
+	if c.Workers < 0 {
+		errs = append(errs, errors.New("ingest-workers must not be negative"))
+	}
+	if c.MaxBodySize <= 0 {
+		errs = append(errs, errors.New("webhook-max-body-size must be positive"))
+	}
+	if err := ingestion.ValidateClusterName(c.DefaultCluster); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Sampling.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Redaction.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the redaction rules and that a key file is set when redaction is enabled
+func (c *RedactionConfig) Validate() error {
+	if c.Disabled {
+		return nil
+	}
+	if c.KeyFile == "" {
+		return errors.New("redaction-key-file is required unless redaction is disabled")
+	}
+	return redaction.ValidateRules(c.Rules)
+}
+
+// QueueOptions returns the ingestion queue options
+func (c *IngestionConfig) QueueOptions() ingestion.QueueOptions {
+	opts := ingestion.DefaultQueueOptions()
+	opts.Capacity = c.QueueSize
+	opts.BatchSize = c.BatchSize
+	opts.FlushInterval = c.FlushInterval.Duration
+	opts.Workers = c.Workers
+	return opts
+}
+
+// Validate checks the compression level
+func (c *RawCompressionConfig) Validate() error {
+	if ok, _ := zstd.EncoderLevelFromString(c.Level); !ok {
+		return fmt.Errorf("unknown raw compression level %q", c.Level)
+	}
+	return nil
+}
+
+// Validate checks the inline policy and the pruner schedule
+func (c *RetentionConfig) Validate() error {
+	var errs []error
+	if err := c.Policy.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.PruneInterval.Duration <= 0 {
+		errs = append(errs, errors.New("prune-interval must be positive"))
+	}
+	if c.PruneBatchSize <= 0 {
+		errs = append(errs, errors.New("prune-batch-size must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+// LoadPolicy returns the retention policy, read from PolicyFile when set. A
+// default TTL set in the configuration overrides the one of the file.
+func (c *RetentionConfig) LoadPolicy() (*retention.Policy, error) {
+	policy := c.Policy
+	if c.PolicyFile != "" {
+		loaded, err := retention.LoadPolicy(c.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		if c.DefaultTTL != 0 {
+			loaded.DefaultTTL = c.DefaultTTL
+		}
+		policy = *loaded
+	}
+	return &policy, policy.Validate()
+}
+
+// PrunerOptions returns the options of the background pruner
+func (c *RetentionConfig) PrunerOptions() retention.Options {
+	opts := retention.DefaultOptions()
+	opts.Interval = c.PruneInterval.Duration
+	opts.BatchSize = c.PruneBatchSize
+	opts.VacuumInterval = c.VacuumInterval.Duration
+	return opts
+}
+
+// Dump returns the configuration as YAML with the database password and the
+// header values of the forwarding sinks hidden
+func (c *Config) Dump() ([]byte, error) {
+	redacted := *c
+	redacted.Database.DSN = database.Redact(c.Database.DSN)
+	redacted.Forwarding.Sinks = make([]forwarding.SinkConfig, len(c.Forwarding.Sinks))
+	for i, sink := range c.Forwarding.Sinks {
+		if sink.Headers != nil {
+			headers := make(map[string]string, len(sink.Headers))
+			for name := range sink.Headers {
+				headers[name] = "xxxxx"
+			}
+			sink.Headers = headers
+		}
+		redacted.Forwarding.Sinks[i] = sink
+	}
+	return yaml.Marshal(redacted)
+}
+
+// AddFlags registers the forwarding flag, the sinks are only read from the configuration file
+func (c *ForwardingConfig) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.QueueDir, "forwarding-queue-dir", c.QueueDir, "directory holding the events not yet delivered to the forwarding sinks")
+}
+
+// Validate checks the sinks without reading the files they refer to
+func (c *ForwardingConfig) Validate() error {
+	if len(c.Sinks) > 0 && c.QueueDir == "" {
+		return errors.New("forwarding-queue-dir is required when forwarding sinks are configured")
+	}
+	var errs []error
+	names := map[string]bool{}
+	for i := range c.Sinks {
+		if err := c.Sinks[i].Validate(); err != nil {
+			errs = append(errs, err)
+		} else if names[c.Sinks[i].Name] {
+			errs = append(errs, fmt.Errorf("forwarding sink %s is defined twice", c.Sinks[i].Name))
+		}
+		names[c.Sinks[i].Name] = true
+	}
+	return errors.Join(errs...)
+}
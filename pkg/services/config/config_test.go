@@ -0,0 +1,176 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/gql"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/config"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/forwarding"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/redaction"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func env(values map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should return valid defaults without a file", func(t *testing.T) {
+		cfg, err := config.Load("")
+		require.NoError(t, err)
+		assert.Equal(t, "0.0.0.0:23333", cfg.Listen.Address)
+		assert.Equal(t, gql.DefaultPageSize, cfg.GraphQL.DefaultPageSize)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("should read the file over the defaults", func(t *testing.T) {
+		path := writeFile(t, "config.yaml", `
+listen:
+  webhookAddress: 0.0.0.0:8443
+database:
+  dsn: postgres://audit:secret@db/audit
+ingestion:
+  workers: 4
+retention:
+  defaultTTL: 30d
+  rules:
+    - resources: [secrets]
+      ttl: 52w
+graphql:
+  defaultPageSize: 50
+`)
+		cfg, err := config.Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, "0.0.0.0:23333", cfg.Listen.Address)
+		assert.Equal(t, "0.0.0.0:8443", cfg.Listen.WebhookAddress)
+		assert.Equal(t, 4, cfg.Ingestion.Workers)
+		assert.Equal(t, 500, cfg.Ingestion.BatchSize)
+		assert.Equal(t, retention.Duration(30*24*time.Hour), cfg.Retention.DefaultTTL)
+		assert.Len(t, cfg.Retention.Rules, 1)
+		assert.Equal(t, 50, cfg.GraphQL.DefaultPageSize)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("should reject unknown settings", func(t *testing.T) {
+		_, err := config.Load(writeFile(t, "config.yaml", "listen:\n  adress: :80\n"))
+		assert.Error(t, err)
+	})
+}
+
+func TestOverrides(t *testing.T) {
+	t.Run("should apply environment variables, then flags", func(t *testing.T) {
+		cfg, err := config.Load(writeFile(t, "config.yaml", "ingestion:\n  workers: 4\n  queueSize: 100\n"))
+		require.NoError(t, err)
+		require.NoError(t, cfg.ApplyEnv(env(map[string]string{
+			"KAD_INGEST_WORKERS":        "8",
+			"KAD_INGEST_FLUSH_INTERVAL": "3s",
+			"KAD_DEFAULT_PAGE_SIZE":     "25",
+		})))
+
+		flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+		cfg.AddFlags(flags)
+		require.NoError(t, flags.Parse([]string{"-ingest-workers", "16"}))
+
+		assert.Equal(t, 16, cfg.Ingestion.Workers)
+		assert.Equal(t, 100, cfg.Ingestion.QueueSize)
+		assert.Equal(t, 3*time.Second, cfg.Ingestion.FlushInterval.Duration)
+		assert.Equal(t, 25, cfg.GraphQL.DefaultPageSize)
+	})
+
+	t.Run("should report invalid environment variables", func(t *testing.T) {
+		cfg := config.Default()
+		err := cfg.ApplyEnv(env(map[string]string{"KAD_INGEST_WORKERS": "many"}))
+		assert.ErrorContains(t, err, "KAD_INGEST_WORKERS")
+	})
+
+	t.Run("should find the configuration file in the arguments or the environment", func(t *testing.T) {
+		none := env(nil)
+		assert.Equal(t, "a.yaml", config.PathFromArgs([]string{"-listen", ":80", "-config", "a.yaml"}, none))
+		assert.Equal(t, "b.yaml", config.PathFromArgs([]string{"--config=b.yaml"}, none))
+		assert.Equal(t, "c.yaml", config.PathFromArgs(nil, env(map[string]string{"KAD_CONFIG": "c.yaml"})))
+		assert.Equal(t, "", config.PathFromArgs([]string{"--", "-config", "d.yaml"}, none))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should report every invalid setting", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Listen.Address = "nowhere"
+		cfg.Database.DSN = "oracle://db"
+		cfg.Ingestion.DefaultCluster = "Not Valid"
+		cfg.Ingestion.QueueSize = 100
+		cfg.RawCompression.Level = "extreme"
+		cfg.GraphQL.DefaultPageSize = 0
+		cfg.Auth.WebhookClientCA = "ca.pem"
+		cfg.Ingestion.Redaction.Rules = []redaction.Rule{{Resource: "configmaps", Paths: []string{"data"}}}
+		cfg.Forwarding.Sinks = []forwarding.SinkConfig{{Name: "siem", URL: "http://siem"}, {Name: "siem", URL: "http://siem"}}
+		cfg.Ingestion.Sampling = sampling.Config{OneIn: 10, PerMinute: 10}
+
+		err := cfg.Validate()
+		require.Error(t, err)
+		for _, message := range []string{"listen", "oracle", "ingest-queue-size", "cluster", "extreme", "default-page-size", "webhook-client-ca", "redaction rule", "sink siem", "mutually exclusive"} {
+			assert.ErrorContains(t, err, message)
+		}
+	})
+
+	t.Run("should default the self-signed certificate paths", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.TLS.SelfSigned = true
+		require.NoError(t, cfg.Validate())
+		assert.Equal(t, "tls.crt", cfg.TLS.CertFile)
+		assert.Equal(t, "tls.key", cfg.TLS.KeyFile)
+	})
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	t.Run("should override the default TTL of the policy file", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Retention.PolicyFile = writeFile(t, "retention.yaml", "defaultTTL: 30d\nrules:\n  - verbs: [delete]\n    ttl: 1w\n")
+		cfg.Retention.DefaultTTL = retention.Duration(time.Hour)
+
+		policy, err := cfg.Retention.LoadPolicy()
+		require.NoError(t, err)
+		assert.Equal(t, retention.Duration(time.Hour), policy.DefaultTTL)
+		assert.Len(t, policy.Rules, 1)
+	})
+}
+
+func TestDump(t *testing.T) {
+	t.Run("should hide the database password", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Database.DSN = "postgres://audit:secret@db/audit"
+
+		dump, err := cfg.Dump()
+		require.NoError(t, err)
+		assert.NotContains(t, string(dump), "secret")
+		assert.Contains(t, string(dump), "postgres://audit:xxxxx@db/audit")
+		assert.Equal(t, "postgres://audit:secret@db/audit", cfg.Database.DSN)
+	})
+
+	t.Run("should hide the headers of the forwarding sinks", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Forwarding.Sinks = []forwarding.SinkConfig{{Name: "siem", URL: "https://siem", Headers: map[string]string{"X-Api-Key": "secret"}}}
+
+		dump, err := cfg.Dump()
+		require.NoError(t, err)
+		assert.NotContains(t, string(dump), "secret")
+		assert.Contains(t, string(dump), "X-Api-Key: xxxxx")
+		assert.Equal(t, "secret", cfg.Forwarding.Sinks[0].Headers["X-Api-Key"])
+	})
+}
@@ -0,0 +1,135 @@
+package forwarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// DefaultQueueDir is the directory holding the queues of the sinks
+const DefaultQueueDir = "forwarding-queues"
+
+// Forwarder fans the stored events out to the sinks. Events are queued on disk
+// synchronously, so that they survive restarts, and delivered in the background.
+type Forwarder struct {
+	sinks []*Sink
+}
+
+var _ ingestion.Forwarder = (*Forwarder)(nil)
+
+// NewForwarder loads the sinks and opens their queues, one directory per sink under queueDir
+func NewForwarder(configs []SinkConfig, queueDir string) (*Forwarder, error) {
+	f := &Forwarder{}
+	names := map[string]bool{}
+	for _, config := range configs {
+		if names[config.Name] {
+			return nil, fmt.Errorf("forwarding sink %s is defined twice", config.Name)
+		}
+		names[config.Name] = true
+		sink, err := newSink(config, queueDir)
+		if err != nil {
+			return nil, err
+		}
+		f.sinks = append(f.sinks, sink)
+	}
+	return f, nil
+}
+
+// CheckSinks checks the sinks and the files they refer to like NewForwarder,
+// without creating the queue directories: queueDir, or the closest of its
+// parents that exists, must be a directory
+func CheckSinks(configs []SinkConfig, queueDir string) error {
+	names := map[string]bool{}
+	for _, config := range configs {
+		if names[config.Name] {
+			return fmt.Errorf("forwarding sink %s is defined twice", config.Name)
+		}
+		names[config.Name] = true
+		if _, err := loadSink(config); err != nil {
+			return err
+		}
+	}
+	for dir := filepath.Clean(queueDir); ; dir = filepath.Dir(dir) {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("forwarding queue directory %s cannot be created: %s is not a directory", queueDir, dir)
+			}
+			return nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("forwarding queue directory %s: %w", queueDir, err)
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return nil
+		}
+	}
+}
+
+// Forward implements ingestion.Forwarder
+func (f *Forwarder) Forward(entries []ingestion.Entry) {
+	for _, sink := range f.sinks {
+		sink.enqueue(entries)
+	}
+}
+
+// Run delivers the queued events of every sink until the context is cancelled.
+// Undelivered events stay queued for the next run.
+func (f *Forwarder) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, sink := range f.sinks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sink.run(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// Stats returns the counters of every sink
+func (f *Forwarder) Stats() []SinkStats {
+	stats := make([]SinkStats, 0, len(f.sinks))
+	for _, sink := range f.sinks {
+		stats = append(stats, sink.Stats())
+	}
+	return stats
+}
+
+// NewStatsHandler returns a gin handler exposing the sink statistics as JSON
+func NewStatsHandler(forwarder *Forwarder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, forwarder.Stats())
+	}
+}
+
+// RegisterMetrics exposes the queue and delivery counters of every sink
+func RegisterMetrics(registerer prometheus.Registerer, forwarder *Forwarder) {
+	for _, sink := range forwarder.sinks {
+		labels := prometheus.Labels{"sink": sink.config.Name}
+		gauge := func(name, help string, value func(SinkStats) float64) prometheus.Collector {
+			return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Namespace: "kad", Subsystem: "forwarding", Name: name, Help: help, ConstLabels: labels},
+				func() float64 { return value(sink.Stats()) })
+		}
+		counter := func(name, help string, value func(SinkStats) float64) prometheus.Collector {
+			return prometheus.NewCounterFunc(prometheus.CounterOpts{Namespace: "kad", Subsystem: "forwarding", Name: name, Help: help, ConstLabels: labels},
+				func() float64 { return value(sink.Stats()) })
+		}
+		registerer.MustRegister(
+			gauge("queued_events", "Events waiting on disk to be forwarded.", func(s SinkStats) float64 { return float64(s.QueuedEvents) }),
+			gauge("queued_bytes", "Space taken on disk by the events waiting to be forwarded.", func(s SinkStats) float64 { return float64(s.QueuedBytes) }),
+			counter("sent_events_total", "Events accepted by the sink.", func(s SinkStats) float64 { return float64(s.Sent) }),
+			counter("retries_total", "Failed deliveries retried later.", func(s SinkStats) float64 { return float64(s.Retries) }),
+			counter("dropped_events_total", "Events rejected by the sink or removed from a full queue.", func(s SinkStats) float64 { return float64(s.Dropped) }),
+		)
+	}
+}
@@ -0,0 +1,260 @@
+package forwarding_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/forwarding"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func newEntry(auditID, verb string) ingestion.Entry {
+	now := metav1.NewMicroTime(time.Now())
+	return ingestion.Entry{Cluster: "default", Event: auditv1.Event{
+		Level:                    auditv1.LevelMetadata,
+		AuditID:                  types.UID(auditID),
+		Stage:                    auditv1.StageResponseComplete,
+		Verb:                     verb,
+		UserAgent:                "kubectl/v1.30.0",
+		RequestReceivedTimestamp: now,
+		StageTimestamp:           now,
+		ObjectRef:                &auditv1.ObjectReference{Resource: "configmaps", Namespace: "default", Name: "settings", APIVersion: "v1"},
+	}}
+}
+
+// receiver records the EventLists posted to it, answering with the given status codes in turn and 200 afterwards
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	ids      []string
+	headers  http.Header
+	statuses []int
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if len(r.statuses) > 0 {
+			status := r.statuses[0]
+			r.statuses = r.statuses[1:]
+			w.WriteHeader(status)
+			return
+		}
+		var list auditv1.EventList
+		if err := json.NewDecoder(req.Body).Decode(&list); err != nil || list.Kind != "EventList" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, event := range list.Items {
+			r.ids = append(r.ids, string(event.AuditID))
+		}
+		r.headers = req.Header.Clone()
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.ids...)
+}
+
+// run delivers the queued events in the background until the test ends
+func run(t *testing.T, forwarder *forwarding.Forwarder) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		forwarder.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func TestForwarder(t *testing.T) {
+	t.Run("should post the events of every sink as EventLists", func(t *testing.T) {
+		siem, archive := newReceiver(t), newReceiver(t)
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("s3cret\n"), 0o600))
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{
+			{Name: "siem", URL: siem.URL, Headers: map[string]string{"X-Api-Key": "key"}, BearerTokenFile: tokenFile},
+			{Name: "archive", URL: archive.URL},
+		}, t.TempDir())
+		require.NoError(t, err)
+		run(t, forwarder)
+
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "create"), newEntry("b", "delete")})
+		forwarder.Forward([]ingestion.Entry{newEntry("c", "update")})
+
+		assert.Eventually(t, func() bool { return len(siem.received()) == 3 && len(archive.received()) == 3 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"a", "b", "c"}, siem.received())
+		assert.Equal(t, "key", siem.headers.Get("X-Api-Key"))
+		assert.Equal(t, "Bearer s3cret", siem.headers.Get("Authorization"))
+		assert.Empty(t, archive.headers.Get("Authorization"))
+
+		stats := forwarder.Stats()
+		assert.Equal(t, int64(3), stats[0].Sent)
+		assert.Zero(t, stats[0].QueuedEvents)
+	})
+
+	t.Run("should only forward the events selected by the policy of the sink", func(t *testing.T) {
+		siem := newReceiver(t)
+		policy := filepath.Join(t.TempDir(), "policy.yaml")
+		require.NoError(t, os.WriteFile(policy, []byte(`apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+  - level: None
+    verbs: ["get", "list", "watch"]
+  - level: Metadata
+`), 0o600))
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: siem.URL, Policy: policy}}, t.TempDir())
+		require.NoError(t, err)
+		run(t, forwarder)
+
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "get"), newEntry("b", "delete")})
+		assert.Eventually(t, func() bool { return len(siem.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"b"}, siem.received())
+	})
+
+	t.Run("should retry unavailable sinks and drop rejected events", func(t *testing.T) {
+		siem := newReceiver(t, http.StatusServiceUnavailable, http.StatusBadRequest)
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: siem.URL}}, t.TempDir())
+		require.NoError(t, err)
+		// Queued before the sink runs, so that the first batch gets both errors
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "create")})
+		forwarder.Forward([]ingestion.Entry{newEntry("b", "create")})
+		run(t, forwarder)
+
+		assert.Eventually(t, func() bool { return len(siem.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"b"}, siem.received())
+		stats := forwarder.Stats()[0]
+		assert.Equal(t, int64(1), stats.Retries)
+		assert.Equal(t, int64(1), stats.Dropped)
+		assert.Contains(t, stats.LastError, "400")
+	})
+
+	t.Run("should retry the events refused for their credentials", func(t *testing.T) {
+		siem := newReceiver(t, http.StatusUnauthorized)
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: siem.URL}}, t.TempDir())
+		require.NoError(t, err)
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "create")})
+		run(t, forwarder)
+
+		assert.Eventually(t, func() bool { return len(siem.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+		stats := forwarder.Stats()[0]
+		assert.Equal(t, int64(1), stats.Retries)
+		assert.Zero(t, stats.Dropped)
+		assert.Contains(t, stats.LastError, "401")
+	})
+
+	t.Run("should keep undelivered events on disk across restarts", func(t *testing.T) {
+		dir := t.TempDir()
+		down := httptest.NewServer(http.NotFoundHandler())
+		down.Close()
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: down.URL}}, dir)
+		require.NoError(t, err)
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "create"), newEntry("b", "create")})
+
+		siem := newReceiver(t)
+		restarted, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: siem.URL}}, dir)
+		require.NoError(t, err)
+		assert.Equal(t, 2, restarted.Stats()[0].QueuedEvents)
+		run(t, restarted)
+		restarted.Forward([]ingestion.Entry{newEntry("c", "create")})
+
+		assert.Eventually(t, func() bool { return len(siem.received()) == 3 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"a", "b", "c"}, siem.received())
+	})
+
+	t.Run("should drop the oldest events when the queue is full", func(t *testing.T) {
+		forwarder, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: "http://127.0.0.1:1", MaxQueueSize: 1}}, t.TempDir())
+		require.NoError(t, err)
+		forwarder.Forward([]ingestion.Entry{newEntry("a", "create")})
+		forwarder.Forward([]ingestion.Entry{newEntry("b", "create"), newEntry("c", "create")})
+
+		stats := forwarder.Stats()[0]
+		assert.Equal(t, 1, stats.QueuedBatches)
+		assert.Equal(t, 2, stats.QueuedEvents)
+		assert.Equal(t, int64(1), stats.Dropped)
+	})
+
+	t.Run("should reject invalid sinks", func(t *testing.T) {
+		for _, sink := range []forwarding.SinkConfig{
+			{Name: "SIEM", URL: "http://siem"},
+			{Name: "siem", URL: "ftp://siem"},
+			{Name: "siem", URL: "http://siem", Policy: "missing.yaml"},
+		} {
+			_, err := forwarding.NewForwarder([]forwarding.SinkConfig{sink}, t.TempDir())
+			assert.Error(t, err, sink)
+		}
+		_, err := forwarding.NewForwarder([]forwarding.SinkConfig{{Name: "siem", URL: "http://a"}, {Name: "siem", URL: "http://b"}}, t.TempDir())
+		assert.ErrorContains(t, err, "twice")
+	})
+
+	t.Run("should check the sinks without creating the queue directories", func(t *testing.T) {
+		queueDir := filepath.Join(t.TempDir(), "queues", "nested")
+		sinks := []forwarding.SinkConfig{{Name: "siem", URL: "http://siem"}}
+		require.NoError(t, forwarding.CheckSinks(sinks, queueDir))
+		assert.NoDirExists(t, filepath.Dir(queueDir))
+
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0o600))
+		assert.Error(t, forwarding.CheckSinks(sinks, filepath.Join(file, "queues")))
+		assert.Error(t, forwarding.CheckSinks([]forwarding.SinkConfig{{Name: "siem", URL: "http://siem", Policy: "missing.yaml"}}, queueDir))
+		assert.ErrorContains(t, forwarding.CheckSinks([]forwarding.SinkConfig{sinks[0], sinks[0]}, queueDir), "twice")
+	})
+}
+
+// recorder is a Forwarder remembering the forwarded audit IDs
+type recorder struct {
+	ids atomic.Value
+}
+
+func (r *recorder) Forward(entries []ingestion.Entry) {
+	ids, _ := r.ids.Load().([]string)
+	for _, entry := range entries {
+		ids = append(ids, string(entry.Event.AuditID))
+	}
+	r.ids.Store(ids)
+}
+
+func TestServiceForwarding(t *testing.T) {
+	t.Run("should forward stored events once", func(t *testing.T) {
+		ctx := context.Background()
+		client, _, err := database.Open(fmt.Sprintf("file:forwarding_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63()))
+		require.NoError(t, err)
+		t.Cleanup(func() { client.Close() })
+		require.NoError(t, database.Migrate(ctx, client))
+
+		forwarded := &recorder{}
+		service := ingestion.NewService(client, ingestion.WithForwarder(forwarded))
+		_, err = service.Store(ctx, []ingestion.Entry{newEntry("a", "create"), newEntry("b", "create")})
+		require.NoError(t, err)
+		// Resent by the apiserver webhook backend
+		_, err = service.Store(ctx, []ingestion.Entry{newEntry("b", "create"), newEntry("c", "create")})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c"}, forwarded.ids.Load())
+	})
+}
@@ -0,0 +1,145 @@
+package forwarding
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// batchSuffix ends the name of every batch file of a queue
+const batchSuffix = ".json"
+
+// batch is an EventList waiting in a queue
+type batch struct {
+	name   string
+	events int
+	size   int64
+}
+
+// diskQueue persists the batches of a sink as one file each, named after their
+// sequence number and event count, so that they survive restarts until sent
+type diskQueue struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	batches []batch
+	size    int64
+	next    uint64
+	notify  chan struct{}
+}
+
+// openQueue opens the queue stored in dir, creating the directory when missing
+func openQueue(dir string, maxSize int64) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	q := &diskQueue{dir: dir, maxSize: maxSize, notify: make(chan struct{}, 1)}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".tmp-") {
+			// Left over by a write interrupted before its rename
+			_ = os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		var seq uint64
+		var events int
+		if _, err := fmt.Sscanf(name, "%d-%d"+batchSuffix, &seq, &events); err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		q.batches = append(q.batches, batch{name: name, events: events, size: info.Size()})
+		q.size += info.Size()
+		q.next = max(q.next, seq+1)
+	}
+	sort.Slice(q.batches, func(i, j int) bool { return q.batches[i].name < q.batches[j].name })
+	return q, nil
+}
+
+// push writes a batch at the end of the queue. When the queue would exceed its
+// maximum size, the oldest batches are removed and their event count returned.
+func (q *diskQueue) push(data []byte, events int) (dropped int, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	name := fmt.Sprintf("%020d-%d%s", q.next, events, batchSuffix)
+	tmp := filepath.Join(q.dir, ".tmp-"+name)
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, filepath.Join(q.dir, name)); err != nil {
+		return 0, errors.Join(err, os.Remove(tmp))
+	}
+	q.next++
+	q.batches = append(q.batches, batch{name: name, events: events, size: int64(len(data))})
+	q.size += int64(len(data))
+
+	for q.maxSize > 0 && q.size > q.maxSize && len(q.batches) > 1 {
+		oldest := q.batches[0]
+		if err := q.removeLocked(oldest.name); err != nil {
+			return dropped, err
+		}
+		dropped += oldest.events
+	}
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return dropped, nil
+}
+
+// peek returns the oldest batch and its content, false when the queue is empty
+func (q *diskQueue) peek() (batch, []byte, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.batches) == 0 {
+		return batch{}, nil, false, nil
+	}
+	oldest := q.batches[0]
+	data, err := os.ReadFile(filepath.Join(q.dir, oldest.name))
+	return oldest, data, true, err
+}
+
+// remove deletes a batch, which may already have been dropped by push
+func (q *diskQueue) remove(name string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.removeLocked(name)
+}
+
+func (q *diskQueue) removeLocked(name string) error {
+	for i, b := range q.batches {
+		if b.name != name {
+			continue
+		}
+		if err := os.Remove(filepath.Join(q.dir, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		q.batches = append(q.batches[:i], q.batches[i+1:]...)
+		q.size -= b.size
+		return nil
+	}
+	return nil
+}
+
+// stats returns the number of batches, events and bytes queued
+func (q *diskQueue) stats() (batches, events int, size int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, b := range q.batches {
+		events += b.events
+	}
+	return len(q.batches), events, q.size
+}
@@ -0,0 +1,320 @@
+package forwarding
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+const (
+	// DefaultTimeout bounds every request to a sink
+	DefaultTimeout = 30 * time.Second
+	// DefaultMaxQueueSize is the space the queue of a sink may take on disk
+	DefaultMaxQueueSize = 1 << 30
+
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+var sinkNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// SinkConfig is a downstream webhook receiving the ingested events as EventLists
+type SinkConfig struct {
+	// Name identifies the sink in metrics and names its queue directory
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Policy is an audit.k8s.io/v1 Policy file selecting the forwarded events and
+	// their level, like the ingest filter. Every event is forwarded without it.
+	Policy string `json:"policy,omitempty"`
+	// Headers are added to every request, e.g. an API key of the SIEM
+	Headers         map[string]string `json:"headers,omitempty"`
+	BearerTokenFile string            `json:"bearerTokenFile,omitempty"`
+	// CAFile verifies the certificate of the sink instead of the system roots
+	CAFile  string          `json:"caFile,omitempty"`
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// MaxQueueSize is the size in bytes above which the oldest queued events are dropped
+	MaxQueueSize int64 `json:"maxQueueSize,omitempty"`
+}
+
+// Validate checks the sink without reading the files it refers to
+func (c *SinkConfig) Validate() error {
+	if !sinkNamePattern.MatchString(c.Name) {
+		return fmt.Errorf("forwarding sink name %q must consist of lower case alphanumeric characters or '-'", c.Name)
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("forwarding sink %s: url must be an http or https URL", c.Name)
+	}
+	if c.Timeout.Duration < 0 || c.MaxQueueSize < 0 {
+		return fmt.Errorf("forwarding sink %s: timeout and maxQueueSize must not be negative", c.Name)
+	}
+	return nil
+}
+
+// SinkStats is a snapshot of the counters of a sink
+type SinkStats struct {
+	Name          string `json:"name"`
+	QueuedBatches int    `json:"queuedBatches"`
+	QueuedEvents  int    `json:"queuedEvents"`
+	QueuedBytes   int64  `json:"queuedBytes"`
+	// Sent is the number of events accepted by the sink
+	Sent int64 `json:"sent"`
+	// Retries is the number of failed deliveries that were retried
+	Retries int64 `json:"retries"`
+	// Dropped is the number of events discarded: rejected by the sink, or removed from a full queue
+	Dropped   int64  `json:"dropped"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Sink forwards events to one downstream webhook through a queue persisted on disk
+type Sink struct {
+	config  SinkConfig
+	filter  *auditfilter.Filter
+	queue   *diskQueue
+	client  *http.Client
+	token   string
+	logger  *slog.Logger
+	sent    atomic.Int64
+	retries atomic.Int64
+	dropped atomic.Int64
+
+	mu        sync.Mutex
+	lastError string
+}
+
+// newSink loads the files the sink refers to and opens its queue under queueDir
+func newSink(config SinkConfig, queueDir string) (*Sink, error) {
+	s, err := loadSink(config)
+	if err != nil {
+		return nil, err
+	}
+	maxQueueSize := config.MaxQueueSize
+	if maxQueueSize == 0 {
+		maxQueueSize = DefaultMaxQueueSize
+	}
+	queue, err := openQueue(filepath.Join(queueDir, config.Name), maxQueueSize)
+	if err != nil {
+		return nil, fmt.Errorf("forwarding sink %s: %w", config.Name, err)
+	}
+	s.queue = queue
+	return s, nil
+}
+
+// loadSink validates the sink and loads the files it refers to, leaving its queue unopened
+func loadSink(config SinkConfig) (*Sink, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Sink{config: config, logger: slog.Default().With("component", "forwarding", "sink", config.Name)}
+	if config.Policy != "" {
+		filter, err := auditfilter.LoadFilter(config.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("forwarding sink %s: %w", config.Name, err)
+		}
+		s.filter = filter
+	}
+	if config.BearerTokenFile != "" {
+		token, err := os.ReadFile(config.BearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("forwarding sink %s: %w", config.Name, err)
+		}
+		s.token = strings.TrimSpace(string(token))
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("forwarding sink %s: %w", config.Name, err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("forwarding sink %s: no certificate found in %s", config.Name, config.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: roots}
+	}
+	timeout := config.Timeout.Duration
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	s.client = &http.Client{Transport: transport, Timeout: timeout}
+	return s, nil
+}
+
+// enqueue selects the events of the sink and queues them as one EventList
+func (s *Sink) enqueue(entries []ingestion.Entry) {
+	list := auditv1.EventList{TypeMeta: metav1.TypeMeta{APIVersion: auditv1.SchemeGroupVersion.String(), Kind: "EventList"}}
+	for i := range entries {
+		event := entries[i].Event
+		if s.filter != nil {
+			// The filter lowers the level in place, other sinks get the event unchanged
+			copied := entries[i].Event.DeepCopy()
+			if !s.filter.Apply(copied) {
+				continue
+			}
+			event = *copied
+		}
+		list.Items = append(list.Items, event)
+	}
+	if len(list.Items) == 0 {
+		return
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		s.fail(len(list.Items), "failed to encode events", err)
+		return
+	}
+	dropped, err := s.queue.push(data, len(list.Items))
+	if err != nil {
+		s.fail(len(list.Items), "failed to queue events", err)
+		return
+	}
+	if dropped > 0 {
+		s.dropped.Add(int64(dropped))
+		s.logger.Warn("forwarding queue full, dropped the oldest events", "events", dropped)
+	}
+}
+
+// run delivers the queued batches in order until the context is cancelled,
+// retrying with an exponential backoff while the sink is unavailable
+func (s *Sink) run(ctx context.Context) {
+	backoff := minBackoff
+	for {
+		b, data, ok, err := s.queue.peek()
+		if err != nil {
+			s.logger.Error("failed to read queued events, dropping them", "batch", b.name, "error", err)
+			s.dropped.Add(int64(b.events))
+			if err := s.queue.remove(b.name); err != nil {
+				s.logger.Error("failed to remove queued events", "batch", b.name, "error", err)
+				return
+			}
+			continue
+		}
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.queue.notify:
+				continue
+			}
+		}
+
+		err = s.send(ctx, data)
+		var permanent *permanentError
+		switch {
+		case err == nil:
+			s.sent.Add(int64(b.events))
+			backoff = minBackoff
+		case errors.As(err, &permanent):
+			s.fail(b.events, "sink rejected events, dropping them", err)
+		default:
+			if ctx.Err() != nil {
+				return
+			}
+			s.retries.Add(1)
+			s.setLastError(err)
+			s.logger.Warn("failed to forward events, retrying", "events", b.events, "backoff", backoff, "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxBackoff)
+			continue
+		}
+		if err := s.queue.remove(b.name); err != nil {
+			s.logger.Error("failed to remove forwarded events", "batch", b.name, "error", err)
+			return
+		}
+	}
+}
+
+// permanentError is a response that retrying the same batch would not change
+type permanentError struct {
+	status int
+	body   string
+}
+
+func (e *permanentError) Error() string {
+	return fmt.Sprintf("sink answered %d: %s", e.status, e.body)
+}
+
+// send posts a batch, returning a permanentError for the 400, 413 and 422
+// responses only: authentication and URL errors are fixed on the sink side
+// while the events wait in the queue
+func (s *Sink) send(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(data))
+	if err != nil {
+		return &permanentError{body: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.config.Headers {
+		req.Header.Set(name, value)
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusRequestEntityTooLarge ||
+		resp.StatusCode == http.StatusUnprocessableEntity:
+		return &permanentError{status: resp.StatusCode, body: strings.TrimSpace(string(body))}
+	default:
+		return fmt.Errorf("sink answered %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
+
+func (s *Sink) fail(events int, message string, err error) {
+	s.dropped.Add(int64(events))
+	s.setLastError(err)
+	s.logger.Error(message, "events", events, "error", err)
+}
+
+func (s *Sink) setLastError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastError = err.Error()
+}
+
+// Stats returns a snapshot of the counters of the sink
+func (s *Sink) Stats() SinkStats {
+	batches, events, size := s.queue.stats()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return SinkStats{
+		Name:          s.config.Name,
+		QueuedBatches: batches,
+		QueuedEvents:  events,
+		QueuedBytes:   size,
+		Sent:          s.sent.Load(),
+		Retries:       s.retries.Load(),
+		Dropped:       s.dropped.Load(),
+		LastError:     s.lastError,
+	}
+}
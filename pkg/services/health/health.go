@@ -0,0 +1,115 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// checkTimeout bounds every readiness check
+const checkTimeout = 5 * time.Second
+
+// ErrShuttingDown is reported by the readiness endpoint once shutdown began
+var ErrShuttingDown = errors.New("shutting down")
+
+// Check returns an error when a dependency of the server is not usable
+type Check func(ctx context.Context) error
+
+// Status is the JSON body of the health endpoints
+type Status struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker serves the liveness and readiness endpoints. The process is live as
+// long as it answers; it is ready when every readiness check passes and it is
+// not shutting down, so that load balancers stop sending requests before the
+// listeners close.
+type Checker struct {
+	mu           sync.RWMutex
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+// NewChecker creates a checker without readiness checks
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// AddReadinessCheck adds a check run by every readiness probe
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// SetShuttingDown makes the readiness probes fail from now on
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Ready runs the readiness checks concurrently and returns the status of each of them
+func (c *Checker) Ready(ctx context.Context) (Status, bool) {
+	if c.shuttingDown.Load() {
+		return Status{Status: ErrShuttingDown.Error()}, false
+	}
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	results := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check.check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	status, ready := Status{Status: "ok", Checks: make(map[string]string, len(checks))}, true
+	for i, check := range checks {
+		if results[i] != nil {
+			status.Checks[check.name] = results[i].Error()
+			ready = false
+		} else {
+			status.Checks[check.name] = "ok"
+		}
+	}
+	if !ready {
+		status.Status = "not ready"
+	}
+	return status, ready
+}
+
+// LivenessHandler returns a gin handler answering as long as the process serves requests
+func (c *Checker) LivenessHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, Status{Status: "ok"})
+	}
+}
+
+// ReadinessHandler returns a gin handler answering 503 when the server is not ready
+func (c *Checker) ReadinessHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		status, ready := c.Ready(ctx.Request.Context())
+		if !ready {
+			ctx.JSON(http.StatusServiceUnavailable, status)
+			return
+		}
+		ctx.JSON(http.StatusOK, status)
+	}
+}
@@ -0,0 +1,63 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/health"
+)
+
+func probe(t *testing.T, handler gin.HandlerFunc) (int, health.Status) {
+	gin.SetMode(gin.TestMode)
+	app := gin.New()
+	app.GET("/probe", handler)
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/probe", nil))
+	var status health.Status
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+	return recorder.Code, status
+}
+
+func TestChecker(t *testing.T) {
+	t.Run("should be ready when every check passes", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.AddReadinessCheck("database", func(context.Context) error { return nil })
+
+		code, status := probe(t, checker.ReadinessHandler())
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", status.Status)
+		assert.Equal(t, map[string]string{"database": "ok"}, status.Checks)
+	})
+
+	t.Run("should report the failing checks", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.AddReadinessCheck("database", func(context.Context) error { return nil })
+		checker.AddReadinessCheck("ingestion-queue", func(context.Context) error { return errors.New("queue is full") })
+
+		code, status := probe(t, checker.ReadinessHandler())
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "not ready", status.Status)
+		assert.Equal(t, "queue is full", status.Checks["ingestion-queue"])
+		assert.Equal(t, "ok", status.Checks["database"])
+
+		// Liveness does not depend on the checks
+		code, _ = probe(t, checker.LivenessHandler())
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("should not be ready once shutting down", func(t *testing.T) {
+		checker := health.NewChecker()
+		checker.SetShuttingDown()
+
+		code, status := probe(t, checker.ReadinessHandler())
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, health.ErrShuttingDown.Error(), status.Status)
+	})
+}
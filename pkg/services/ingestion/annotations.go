@@ -0,0 +1,39 @@
+package ingestion
+
+import (
+	"context"
+	"sort"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+)
+
+// annotationChunkSize bounds the number of annotations inserted per statement
+const annotationChunkSize = 1000
+
+// storeAnnotations stores the annotations of each entry linked to its created event,
+// events and entries being in the same order
+func storeAnnotations(ctx context.Context, client *ent.Client, events []*ent.AuditEvent, entries []Entry) error {
+	var builders []*ent.AuditAnnotationCreate
+	for i, event := range events {
+		annotations := entries[i].Event.Annotations
+		keys := make([]string, 0, len(annotations))
+		for key := range annotations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			builders = append(builders, client.AuditAnnotation.Create().
+				SetKey(key).
+				SetValue(annotations[key]).
+				SetEvent(event))
+		}
+	}
+
+	for start := 0; start < len(builders); start += annotationChunkSize {
+		end := min(start+annotationChunkSize, len(builders))
+		if err := client.AuditAnnotation.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package ingestion
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	"sigs.k8s.io/yaml"
+)
+
+// Reasons a webhook request is rejected by the Authenticator
+const (
+	RejectMissingCredentials = "missing_credentials"
+	RejectInvalidToken       = "invalid_token"
+	RejectInvalidCertificate = "invalid_certificate"
+)
+
+// ClusterToken is a bearer token accepted by the webhook, as set in the
+// `token` field of the apiserver audit-webhook kubeconfig
+type ClusterToken struct {
+	Token string `json:"token"`
+	// Cluster the events sent with this token are attributed to, empty for no attribution
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// ClusterCertificate attributes client certificates to a cluster by their subject common name
+type ClusterCertificate struct {
+	CommonName string `json:"commonName"`
+	Cluster    string `json:"cluster"`
+}
+
+// Credentials is the format of the webhook credentials file
+type Credentials struct {
+	Tokens             []ClusterToken       `json:"tokens,omitempty"`
+	ClientCertificates []ClusterCertificate `json:"clientCertificates,omitempty"`
+}
+
+// LoadCredentials reads and validates a YAML webhook credentials file
+func LoadCredentials(path string) (*Credentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var credentials Credentials
+	if err := yaml.UnmarshalStrict(data, &credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook credentials %s: %w", path, err)
+	}
+
+	seen := map[string]bool{}
+	for i, token := range credentials.Tokens {
+		if token.Token == "" {
+			return nil, fmt.Errorf("token %d in %s is empty", i, path)
+		}
+		if seen[token.Token] {
+			return nil, fmt.Errorf("token %d in %s is listed twice", i, path)
+		}
+		seen[token.Token] = true
+		if token.Cluster != "" {
+			if err := ValidateClusterName(token.Cluster); err != nil {
+				return nil, fmt.Errorf("token %d in %s: %w", i, path, err)
+			}
+		}
+	}
+	for i, certificate := range credentials.ClientCertificates {
+		if certificate.CommonName == "" {
+			return nil, fmt.Errorf("client certificate %d in %s has an empty commonName", i, path)
+		}
+		if err := ValidateClusterName(certificate.Cluster); err != nil {
+			return nil, fmt.Errorf("client certificate %d in %s: %w", i, path, err)
+		}
+	}
+	return &credentials, nil
+}
+
+// LoadClientCAs reads a PEM bundle of the CAs signing webhook client certificates
+func LoadClientCAs(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+// AuthStats counts the outcome of webhook authentication
+type AuthStats struct {
+	Authenticated int64 `json:"authenticated"`
+	// Rejected counts rejected requests by reason
+	Rejected map[string]int64 `json:"rejected"`
+}
+
+// Authenticator guards the audit webhook. A request is accepted when it carries
+// a configured bearer token or a client certificate signed by one of the client
+// CAs, and is attributed to the cluster mapped to that credential.
+type Authenticator struct {
+	// tokens maps the SHA-256 of each token to its cluster, so that lookups
+	// do not compare the secret itself
+	tokens       map[[sha256.Size]byte]string
+	clientCAs    *x509.CertPool
+	certClusters map[string]string
+
+	authenticated atomic.Int64
+	mu            sync.Mutex
+	rejected      map[string]int64
+}
+
+// NewAuthenticator creates an authenticator from the credentials and client CAs, both optional
+func NewAuthenticator(credentials *Credentials, clientCAs *x509.CertPool) *Authenticator {
+	a := &Authenticator{
+		tokens:       map[[sha256.Size]byte]string{},
+		clientCAs:    clientCAs,
+		certClusters: map[string]string{},
+		rejected:     map[string]int64{},
+	}
+	if credentials != nil {
+		for _, token := range credentials.Tokens {
+			a.tokens[sha256.Sum256([]byte(token.Token))] = token.Cluster
+		}
+		for _, certificate := range credentials.ClientCertificates {
+			a.certClusters[certificate.CommonName] = certificate.Cluster
+		}
+	}
+	return a
+}
+
+// Enabled reports whether any credential is configured. Without credentials the
+// webhook stays open, as it was before authentication existed.
+func (a *Authenticator) Enabled() bool {
+	return len(a.tokens) > 0 || a.clientCAs != nil
+}
+
+// RequiresClientCertificates reports whether client certificates are verified,
+// in which case the webhook has to be served over TLS
+func (a *Authenticator) RequiresClientCertificates() bool {
+	return a.clientCAs != nil
+}
+
+// Stats returns a snapshot of the authentication counters
+func (a *Authenticator) Stats() AuthStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	rejected := make(map[string]int64, len(a.rejected))
+	for reason, count := range a.rejected {
+		rejected[reason] = count
+	}
+	return AuthStats{Authenticated: a.authenticated.Load(), Rejected: rejected}
+}
+
+// Middleware returns a gin middleware rejecting unauthenticated requests with 401
+func (a *Authenticator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !a.Enabled() {
+			c.Next()
+			return
+		}
+
+		cluster, reason, err := a.authenticate(c.Request)
+		if err != nil {
+			a.reject(reason)
+			c.Header("WWW-Authenticate", `Bearer realm="audit-webhook"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, WebhookResponse{Error: err.Error()})
+			return
+		}
+		a.authenticated.Add(1)
+		if cluster != "" {
+			SetRequestCluster(c, cluster)
+		}
+		c.Next()
+	}
+}
+
+// authenticate returns the cluster of the credential carried by the request,
+// or the reason it is rejected. A client certificate takes precedence over a token.
+func (a *Authenticator) authenticate(req *http.Request) (string, string, error) {
+	if a.clientCAs != nil && req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		cluster, err := a.verifyCertificate(req.TLS.PeerCertificates)
+		if err != nil {
+			return "", RejectInvalidCertificate, err
+		}
+		return cluster, "", nil
+	}
+
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return "", RejectMissingCredentials, errors.New("a bearer token or client certificate is required")
+	}
+	cluster, found := a.tokens[sha256.Sum256([]byte(strings.TrimSpace(token)))]
+	if !found {
+		return "", RejectInvalidToken, errors.New("invalid bearer token")
+	}
+	return cluster, "", nil
+}
+
+// verifyCertificate checks the client certificate chain against the client CAs
+func (a *Authenticator) verifyCertificate(chain []*x509.Certificate) (string, error) {
+	intermediates := x509.NewCertPool()
+	for _, certificate := range chain[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         a.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid client certificate: %w", err)
+	}
+	return a.certClusters[chain[0].Subject.CommonName], nil
+}
+
+func (a *Authenticator) reject(reason string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.rejected[reason]++
+}
+
+// NewAuthStatsHandler returns a gin handler exposing the authentication counters
+func NewAuthStatsHandler(authenticator *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, authenticator.Stats())
+	}
+}
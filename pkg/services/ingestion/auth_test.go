@@ -0,0 +1,169 @@
+package ingestion_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// newTestCertificate issues a certificate signed by parent, self-signed when parent is nil
+func newTestCertificate(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return certificate, key
+}
+
+func newAuthRouter(service *ingestion.Service, authenticator *ingestion.Authenticator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/api/audit-webhook", authenticator.Middleware(), ingestion.NewWebhookHandler(service, service))
+	return router
+}
+
+func postWithCertificate(t *testing.T, router http.Handler, certificate *x509.Certificate) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/audit-webhook", strings.NewReader(eventListBody(t, newTestEvent("a", "create"))))
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAuthenticator(t *testing.T) {
+	credentials := &ingestion.Credentials{
+		Tokens:             []ingestion.ClusterToken{{Token: "prod-token", Cluster: "prod"}},
+		ClientCertificates: []ingestion.ClusterCertificate{{CommonName: "apiserver-dev", Cluster: "dev"}},
+	}
+
+	t.Run("should accept anonymous requests when no credential is configured", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newAuthRouter(ingestion.NewService(client), ingestion.NewAuthenticator(nil, nil))
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook", "", eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("should reject requests without or with unknown tokens", func(t *testing.T) {
+		client := setupTestDB(t)
+		authenticator := ingestion.NewAuthenticator(credentials, nil)
+		router := newAuthRouter(ingestion.NewService(client), authenticator)
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook", "", eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+		recorder = postClusterWebhook(router, "/api/audit-webhook", "forged", eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		count, err := client.AuditEvent.Query().Count(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		stats := authenticator.Stats()
+		assert.Equal(t, int64(1), stats.Rejected[ingestion.RejectMissingCredentials])
+		assert.Equal(t, int64(1), stats.Rejected[ingestion.RejectInvalidToken])
+		assert.Zero(t, stats.Authenticated)
+	})
+
+	t.Run("should accept a known token and attribute its cluster", func(t *testing.T) {
+		client := setupTestDB(t)
+		authenticator := ingestion.NewAuthenticator(credentials, nil)
+		router := newAuthRouter(ingestion.NewService(client), authenticator)
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook", "prod-token", eventListBody(t, newTestEvent("a", "create")))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		stored, err := client.AuditEvent.Query().Only(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "prod", stored.Cluster)
+		assert.Equal(t, int64(1), authenticator.Stats().Authenticated)
+	})
+
+	t.Run("should accept a client certificate signed by the client CA", func(t *testing.T) {
+		ca, caKey := newTestCertificate(t, "test-ca", nil, nil)
+		leaf, _ := newTestCertificate(t, "apiserver-dev", ca, caKey)
+		pool := x509.NewCertPool()
+		pool.AddCert(ca)
+
+		client := setupTestDB(t)
+		router := newAuthRouter(ingestion.NewService(client), ingestion.NewAuthenticator(credentials, pool))
+
+		recorder := postWithCertificate(t, router, leaf)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		stored, err := client.AuditEvent.Query().Only(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "dev", stored.Cluster)
+	})
+
+	t.Run("should reject a client certificate signed by another CA", func(t *testing.T) {
+		ca, _ := newTestCertificate(t, "test-ca", nil, nil)
+		otherCA, otherKey := newTestCertificate(t, "other-ca", nil, nil)
+		leaf, _ := newTestCertificate(t, "apiserver-dev", otherCA, otherKey)
+		pool := x509.NewCertPool()
+		pool.AddCert(ca)
+
+		authenticator := ingestion.NewAuthenticator(credentials, pool)
+		router := newAuthRouter(ingestion.NewService(setupTestDB(t)), authenticator)
+
+		recorder := postWithCertificate(t, router, leaf)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Equal(t, int64(1), authenticator.Stats().Rejected[ingestion.RejectInvalidCertificate])
+	})
+}
+
+func TestLoadCredentials(t *testing.T) {
+	t.Run("should load tokens and client certificate mappings", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "credentials.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("tokens:\n- token: abc\n  cluster: prod\n- token: def\nclientCertificates:\n- commonName: apiserver\n  cluster: dev\n"), 0o600))
+
+		credentials, err := ingestion.LoadCredentials(path)
+		require.NoError(t, err)
+		assert.Equal(t, []ingestion.ClusterToken{{Token: "abc", Cluster: "prod"}, {Token: "def"}}, credentials.Tokens)
+		assert.Equal(t, []ingestion.ClusterCertificate{{CommonName: "apiserver", Cluster: "dev"}}, credentials.ClientCertificates)
+	})
+
+	t.Run("should reject invalid cluster names and duplicate tokens", func(t *testing.T) {
+		for _, content := range []string{
+			"tokens:\n- token: abc\n  cluster: Prod_1\n",
+			"tokens:\n- token: abc\n- token: abc\n",
+			"tokens:\n- token: \"\"\n",
+		} {
+			path := filepath.Join(t.TempDir(), "credentials.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			_, err := ingestion.LoadCredentials(path)
+			assert.Error(t, err, content)
+		}
+	})
+}
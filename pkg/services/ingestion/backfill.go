@@ -0,0 +1,71 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+)
+
+// DefaultBackfillBatchSize is the number of rows updated per transaction by Backfill
+const DefaultBackfillBatchSize = 500
+
+// Backfill extracts the user, source and response columns from the raw event of
+// rows stored before these columns existed, which are recognized by an empty
+// username. It returns the number of updated rows. Rows are visited once per
+// call, so it is safe to run repeatedly.
+func Backfill(ctx context.Context, client *ent.Client, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBackfillBatchSize
+	}
+
+	updated, lastID := 0, 0
+	for {
+		rows, err := client.AuditEvent.Query().
+			Where(auditevent.UsernameEQ(""), auditevent.IDGT(lastID)).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(batchSize).
+			Select(auditevent.FieldID, auditevent.FieldRaw).
+			All(ctx)
+		if err != nil {
+			return updated, NewStorageError("backfill", err)
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+		lastID = rows[len(rows)-1].ID
+
+		n, err := backfillRows(ctx, client, rows)
+		updated += n
+		if err != nil {
+			return updated, err
+		}
+	}
+}
+
+func backfillRows(ctx context.Context, client *ent.Client, rows []*ent.AuditEvent) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, NewStorageError("backfill", err)
+	}
+	updated := 0
+	for _, row := range rows {
+		event, err := DecodeEvent([]byte(row.Raw))
+		if err != nil {
+			// Leave undecodable rows alone rather than failing the whole backfill
+			continue
+		}
+		update := tx.AuditEvent.UpdateOneID(row.ID)
+		setExtractedFields(update.Mutation(), event)
+		if err := update.Exec(ctx); err != nil {
+			return 0, NewStorageError("backfill", fmt.Errorf("event %d: %w", row.ID, errors.Join(err, tx.Rollback())))
+		}
+		updated++
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, NewStorageError("backfill", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,83 @@
+package ingestion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func newUserEvent(auditID string) auditv1.Event {
+	event := newTestEvent(auditID, "create")
+	event.User = authnv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated", "devs"}}
+	event.ImpersonatedUser = &authnv1.UserInfo{Username: "bob"}
+	event.SourceIPs = []string{"10.0.0.1"}
+	event.ResponseStatus = &metav1.Status{Code: 201}
+	return event
+}
+
+func TestExtractedFields(t *testing.T) {
+	t.Run("should store user, source and response columns at ingest", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		_, err := ingestion.NewService(client).Ingest(ctx, "", []auditv1.Event{newUserEvent("a")})
+		require.NoError(t, err)
+
+		stored, err := client.AuditEvent.Query().Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", stored.Username)
+		assert.Equal(t, []string{"system:authenticated", "devs"}, stored.UserGroups)
+		assert.Equal(t, []string{"10.0.0.1"}, stored.SourceIPs)
+		assert.Equal(t, 201, stored.ResponseCode)
+		assert.Equal(t, "bob", stored.ImpersonatedUser)
+		assert.Equal(t, "kubectl", stored.UserAgentName)
+	})
+
+	t.Run("should extract the product of user agents", func(t *testing.T) {
+		assert.Equal(t, "kubectl", ingestion.ExtractUserAgentName("kubectl/v1.30.0 (linux/amd64) kubernetes/7c48c2b"))
+		assert.Equal(t, "kube-controller-manager", ingestion.ExtractUserAgentName("kube-controller-manager/v1.30.0 (linux/amd64) kubernetes/7c48c2b/system:serviceaccount:kube-system:deployment-controller"))
+		assert.Equal(t, "curl", ingestion.ExtractUserAgentName("curl"))
+		assert.Equal(t, "", ingestion.ExtractUserAgentName(""))
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	t.Run("should extract the columns of rows stored without them", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		_, err := ingestion.NewService(client).Ingest(ctx, "", []auditv1.Event{newUserEvent("a"), newUserEvent("b")})
+		require.NoError(t, err)
+
+		// Simulate rows written before the columns existed
+		require.NoError(t, client.AuditEvent.Update().
+			SetUsername("").
+			ClearUserGroups().
+			ClearSourceIPs().
+			SetResponseCode(0).
+			SetImpersonatedUser("").
+			SetUserAgentName("").
+			Exec(ctx))
+
+		updated, err := ingestion.Backfill(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+
+		rows, err := client.AuditEvent.Query().All(ctx)
+		require.NoError(t, err)
+		for _, row := range rows {
+			assert.Equal(t, "alice", row.Username)
+			assert.Equal(t, []string{"system:authenticated", "devs"}, row.UserGroups)
+			assert.Equal(t, 201, row.ResponseCode)
+			assert.Equal(t, "bob", row.ImpersonatedUser)
+		}
+
+		updated, err = ingestion.Backfill(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Zero(t, updated)
+	})
+}
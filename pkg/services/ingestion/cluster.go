@@ -0,0 +1,51 @@
+package ingestion
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DefaultCluster is the cluster of events received without any cluster attribution
+const DefaultCluster = "default"
+
+// clusterContextKey is the gin context key holding the cluster a request is attributed to
+const clusterContextKey = "ingestion.cluster"
+
+// ValidateClusterName checks that a cluster name is a DNS subdomain, e.g. "prod-eu-1"
+func ValidateClusterName(name string) error {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return NewValidationError("cluster", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// SetRequestCluster attributes the request to a cluster, typically from the credential it carries
+func SetRequestCluster(c *gin.Context, cluster string) {
+	c.Set(clusterContextKey, cluster)
+}
+
+// requestCluster resolves the cluster of a webhook request from the :cluster path
+// parameter and the attribution made by earlier middleware. When both are present
+// they must agree, so a credential mapped to one cluster cannot write into another.
+func requestCluster(c *gin.Context) (string, int, error) {
+	fromPath := c.Param("cluster")
+	if fromPath != "" {
+		if err := ValidateClusterName(fromPath); err != nil {
+			return "", http.StatusBadRequest, err
+		}
+	}
+
+	fromCredential := c.GetString(clusterContextKey)
+	switch {
+	case fromPath != "" && fromCredential != "" && fromPath != fromCredential:
+		return "", http.StatusForbidden, fmt.Errorf("credential is attributed to cluster %q, not %q", fromCredential, fromPath)
+	case fromPath != "":
+		return fromPath, 0, nil
+	default:
+		return fromCredential, 0, nil
+	}
+}
@@ -0,0 +1,97 @@
+package ingestion_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+func newClusterRouter(service *ingestion.Service, tokens map[string]string) *gin.Engine {
+	credentials := &ingestion.Credentials{}
+	for token, cluster := range tokens {
+		credentials.Tokens = append(credentials.Tokens, ingestion.ClusterToken{Token: token, Cluster: cluster})
+	}
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ingestion.NewAuthenticator(credentials, nil).Middleware())
+	handler := ingestion.NewWebhookHandler(service, service)
+	router.POST("/api/audit-webhook", handler)
+	router.POST("/api/audit-webhook/:cluster", handler)
+	return router
+}
+
+func postClusterWebhook(router http.Handler, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestWebhookClusterAttribution(t *testing.T) {
+	t.Run("should attribute events to the cluster in the path", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newClusterRouter(ingestion.NewService(client), nil)
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook/prod-eu-1", "", eventListBody(t, newTestEvent("a", "create")))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		count, err := client.AuditEvent.Query().Where(auditevent.ClusterEQ("prod-eu-1")).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should fall back to the default cluster", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newClusterRouter(ingestion.NewService(client, ingestion.WithDefaultCluster("staging")), nil)
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook", "", eventListBody(t, newTestEvent("a", "create")))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		stored, err := client.AuditEvent.Query().Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "staging", stored.Cluster)
+	})
+
+	t.Run("should attribute events to the cluster mapped to the token", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newClusterRouter(ingestion.NewService(client), map[string]string{"secret": "prod-us-1"})
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook", "secret", eventListBody(t, newTestEvent("a", "create")))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		stored, err := client.AuditEvent.Query().Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "prod-us-1", stored.Cluster)
+	})
+
+	t.Run("should return 403 when the token belongs to another cluster", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newClusterRouter(ingestion.NewService(client), map[string]string{"secret": "prod-us-1"})
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook/prod-eu-1", "secret", eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("should return 400 for an invalid cluster name", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newClusterRouter(ingestion.NewService(client), nil)
+
+		recorder := postClusterWebhook(router, "/api/audit-webhook/Not_Valid", "", eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
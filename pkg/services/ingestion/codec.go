@@ -0,0 +1,62 @@
+package ingestion
+
+import (
+	"bytes"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditv1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	serializerjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// Scheme contains the audit API versions understood by the ingestion pipeline.
+// Events of older versions are converted to v1 when decoded, so that every
+// stored event has the same shape.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(auditv1.AddToScheme(Scheme))
+	utilruntime.Must(auditv1beta1.AddToScheme(Scheme))
+}
+
+var codec = serializerjson.NewSerializerWithOptions(
+	serializerjson.DefaultMetaFactory, Scheme, Scheme,
+	serializerjson.SerializerOptions{Yaml: false, Pretty: false, Strict: false},
+)
+
+// encodeEvent serializes a single event into the JSON stored in the raw column
+func encodeEvent(event *auditv1.Event) (string, error) {
+	buffer := bytes.Buffer{}
+	if err := codec.Encode(event, &buffer); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// DecodeEvent decodes a single audit.k8s.io Event, as written line by line by the apiserver log backend
+func DecodeEvent(data []byte) (*auditv1.Event, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, NewDecodeError(ErrEmptyBody)
+	}
+	return decodeEvent(data, nil)
+}
+
+// decodeEvent decodes an event of any version of the scheme into v1. The
+// defaults give the version of list items, which carry none of their own.
+func decodeEvent(data []byte, defaults *schema.GroupVersionKind) (*auditv1.Event, error) {
+	obj, _, err := codec.Decode(data, defaults, &auditv1.Event{})
+	if err != nil {
+		return nil, NewDecodeError(err)
+	}
+	if event, ok := obj.(*auditv1.Event); ok {
+		return event, nil
+	}
+	// The serializer decodes other versions into a new object of their own type
+	event := &auditv1.Event{}
+	if err := Scheme.Convert(obj, event, nil); err != nil {
+		return nil, NewDecodeError(err)
+	}
+	return event, nil
+}
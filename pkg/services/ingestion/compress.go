@@ -0,0 +1,144 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+// DefaultCompressBatchSize is the number of rows rewritten per transaction by CompressRaw
+const DefaultCompressBatchSize = 500
+
+// RawStorageStats describes how much space the raw payloads take
+type RawStorageStats struct {
+	Rows int64 `json:"rows"`
+	// PendingRows hold plain payloads, stored before compression or while it was disabled
+	PendingRows int64 `json:"pendingRows"`
+	// StoredBytes is the size of the payloads as stored
+	StoredBytes int64 `json:"storedBytes"`
+	// UncompressedBytes is the size of the payloads once decompressed
+	UncompressedBytes int64 `json:"uncompressedBytes"`
+}
+
+// Ratio is the compression ratio, uncompressed size over stored size
+func (s RawStorageStats) Ratio() float64 {
+	if s.StoredBytes == 0 {
+		return 1
+	}
+	return float64(s.UncompressedBytes) / float64(s.StoredBytes)
+}
+
+// GetRawStorageStats computes the raw storage statistics of every stored event
+func GetRawStorageStats(ctx context.Context, client *ent.Client) (*RawStorageStats, error) {
+	// expr refers to the raw and rawSize columns as {raw} and {rawSize}
+	aggregate := func(alias, expr string) ent.AggregateFunc {
+		return func(s *sql.Selector) string {
+			raw, rawSize := s.C(auditevent.FieldRaw), s.C(auditevent.FieldRawSize)
+			return sql.As(strings.NewReplacer("{raw}", raw, "{rawSize}", rawSize).Replace(expr), alias)
+		}
+	}
+	var rows []struct {
+		Rows              int64 `json:"rows"`
+		PendingRows       int64 `json:"pending_rows"`
+		StoredBytes       int64 `json:"stored_bytes"`
+		UncompressedBytes int64 `json:"uncompressed_bytes"`
+	}
+	err := client.AuditEvent.Query().
+		Aggregate(
+			aggregate("rows", "COUNT(*)"),
+			aggregate("pending_rows", "COALESCE(SUM(CASE WHEN {rawSize} = 0 THEN 1 ELSE 0 END), 0)"),
+			aggregate("stored_bytes", "COALESCE(SUM(LENGTH({raw})), 0)"),
+			// Pending rows are plain, so their stored size is their uncompressed size
+			aggregate("uncompressed_bytes", "COALESCE(SUM(CASE WHEN {rawSize} = 0 THEN LENGTH({raw}) ELSE {rawSize} END), 0)"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, NewStorageError("raw storage stats", err)
+	}
+	if len(rows) == 0 {
+		return &RawStorageStats{}, nil
+	}
+	stats := RawStorageStats(rows[0])
+	return &stats, nil
+}
+
+// CompressRaw rewrites the raw payload of rows stored plain, which are recognized
+// by a zero rawSize, with the configured rawcodec. It returns the number of
+// rewritten rows and is safe to interrupt and run again.
+func CompressRaw(ctx context.Context, client *ent.Client, batchSize int) (int, error) {
+	if !rawcodec.Enabled() {
+		return 0, errors.New("raw compression is disabled")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultCompressBatchSize
+	}
+
+	compressed, lastID := 0, 0
+	for {
+		rows, err := client.AuditEvent.Query().
+			Where(auditevent.RawSizeEQ(0), auditevent.IDGT(lastID)).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(batchSize).
+			Select(auditevent.FieldID, auditevent.FieldRaw).
+			All(ctx)
+		if err != nil {
+			return compressed, NewStorageError("compress raw", err)
+		}
+		if len(rows) == 0 {
+			return compressed, nil
+		}
+		lastID = rows[len(rows)-1].ID
+
+		if err := compressRows(ctx, client, rows); err != nil {
+			return compressed, err
+		}
+		compressed += len(rows)
+		if ctx.Err() != nil {
+			return compressed, ctx.Err()
+		}
+	}
+}
+
+func compressRows(ctx context.Context, client *ent.Client, rows []*ent.AuditEvent) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return NewStorageError("compress raw", err)
+	}
+	for _, row := range rows {
+		err := tx.AuditEvent.UpdateOneID(row.ID).
+			SetRaw(row.Raw).
+			SetRawSize(len(row.Raw)).
+			SetRawKeyID(rawcodec.KeyID()).
+			Exec(ctx)
+		if err != nil {
+			return NewStorageError("compress raw", fmt.Errorf("event %d: %w", row.ID, errors.Join(err, tx.Rollback())))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return NewStorageError("compress raw", err)
+	}
+	return nil
+}
+
+// SampleRaw returns the raw payloads of up to n of the most recent events, to train a dictionary from
+func SampleRaw(ctx context.Context, client *ent.Client, n int) ([][]byte, error) {
+	rows, err := client.AuditEvent.Query().
+		Order(ent.Desc(auditevent.FieldID)).
+		Limit(n).
+		Select(auditevent.FieldRaw).
+		All(ctx)
+	if err != nil {
+		return nil, NewStorageError("sample raw", err)
+	}
+	samples := make([][]byte, 0, len(rows))
+	for _, row := range rows {
+		samples = append(samples, []byte(row.Raw))
+	}
+	return samples, nil
+}
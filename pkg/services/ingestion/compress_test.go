@@ -0,0 +1,52 @@
+package ingestion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func TestCompressRaw(t *testing.T) {
+	t.Run("should compress rows stored uncompressed and report the ratio", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+
+		// Simulate rows written before compression existed
+		require.NoError(t, rawcodec.Configure(rawcodec.Options{Disabled: true}))
+		t.Cleanup(func() { require.NoError(t, rawcodec.Configure(rawcodec.DefaultOptions())) })
+		_, err := ingestion.NewService(client).Ingest(ctx, "", []auditv1.Event{newUserEvent("a"), newUserEvent("b")})
+		require.NoError(t, err)
+		require.NoError(t, rawcodec.Configure(rawcodec.DefaultOptions()))
+
+		before, err := ingestion.GetRawStorageStats(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), before.Rows)
+		assert.Equal(t, int64(2), before.PendingRows)
+		assert.Equal(t, before.StoredBytes, before.UncompressedBytes)
+
+		compressed, err := ingestion.CompressRaw(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, compressed)
+
+		after, err := ingestion.GetRawStorageStats(ctx, client)
+		require.NoError(t, err)
+		assert.Zero(t, after.PendingRows)
+		assert.Equal(t, before.UncompressedBytes, after.UncompressedBytes)
+		assert.Less(t, after.StoredBytes, before.StoredBytes)
+		assert.Greater(t, after.Ratio(), 1.0)
+
+		// Consumers still read the plain JSON
+		stored, err := client.AuditEvent.Query().First(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, stored.Raw, `"username":"alice"`)
+
+		compressed, err = ingestion.CompressRaw(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Zero(t, compressed)
+	})
+}
@@ -0,0 +1,224 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// Kinds of dead letters
+const (
+	// DeadLetterBody is a webhook request body that could not be decoded
+	DeadLetterBody = "body"
+	// DeadLetterEvent is a single event of a webhook batch that failed validation
+	DeadLetterEvent = "event"
+)
+
+// DefaultReprocessBatchSize is the number of dead letters loaded at once by ReprocessDeadLetters
+const DefaultReprocessBatchSize = 100
+
+// WithDeadLetters keeps the webhook bodies that cannot be decoded and the events
+// failing validation as DeadLetter rows, so that they can be reprocessed once
+// the cause is fixed instead of being lost
+func WithDeadLetters() Option {
+	return func(s *Service) {
+		s.deadLetters = true
+	}
+}
+
+// WithoutDeadLetterBodies keeps the rejected events but not the undecodable
+// bodies. Bodies cannot be redacted, so they would be stored in plaintext when
+// the raw payloads are not encrypted either.
+func WithoutDeadLetterBodies() Option {
+	return func(s *Service) {
+		s.skipDeadLetterBodies = true
+	}
+}
+
+// recordDeadLetter stores a payload that could not be ingested. Failures are
+// logged only, the webhook answers the same whether the payload was kept or not.
+func (s *Service) recordDeadLetter(ctx context.Context, kind, cluster, remoteAddr string, payload []byte, reason string) {
+	if !s.deadLetters || len(payload) == 0 {
+		return
+	}
+	if cluster == "" {
+		cluster = s.defaultCluster
+	}
+	err := s.client.DeadLetter.Create().
+		SetKind(kind).
+		SetCluster(cluster).
+		SetRemoteAddr(remoteAddr).
+		SetReason(reason).
+		SetPayload(string(payload)).
+		SetPayloadKeyID(rawcodec.KeyID()).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("failed to store dead letter", "kind", kind, "bytes", len(payload), "error", err)
+	}
+}
+
+// recordRejected stores the events of the batch rejected by Prepare. They go
+// through the processors first, so that events the filter drops are not kept
+// and the bodies are redacted like those of stored events.
+func (s *Service) recordRejected(ctx context.Context, cluster, remoteAddr string, events []auditv1.Event, result *Result) {
+	if !s.deadLetters {
+		return
+	}
+	for _, rejected := range result.Rejected {
+		entry := Entry{Event: events[rejected.Index]}
+		if !s.process(&entry) {
+			continue
+		}
+		payload, err := encodeEvent(&entry.Event)
+		if err != nil {
+			// Keep at least the metadata of events whose objects cannot be encoded
+			entry.Event.RequestObject, entry.Event.ResponseObject = nil, nil
+			payload, err = encodeEvent(&entry.Event)
+		}
+		if err != nil {
+			s.logger.Warn("failed to encode rejected audit event", "index", rejected.Index, "error", err)
+			continue
+		}
+		s.recordDeadLetter(ctx, DeadLetterEvent, cluster, remoteAddr, []byte(payload), rejected.Reason)
+	}
+}
+
+// ReprocessReport summarizes a ReprocessDeadLetters run
+type ReprocessReport struct {
+	// Reprocessed is the number of dead letters ingested, then deleted
+	Reprocessed int `json:"reprocessed"`
+	// Failed is the number of dead letters that still cannot be ingested
+	Failed int `json:"failed"`
+	// Stored is the number of audit events written, duplicates excluded
+	Stored int `json:"stored"`
+}
+
+// ReprocessDeadLetters ingests the dead letters matching the predicates again
+// with the service. Letters ingested without error are deleted; the others are
+// kept with the new reason and one more attempt. Events still rejected by
+// validation count as failures rather than being recorded again.
+func (s *Service) ReprocessDeadLetters(ctx context.Context, batchSize int, predicates ...predicate.DeadLetter) (*ReprocessReport, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultReprocessBatchSize
+	}
+	report, lastID := &ReprocessReport{}, 0
+	for {
+		letters, err := s.client.DeadLetter.Query().
+			Where(deadletter.IDGT(lastID)).
+			Where(predicates...).
+			Order(ent.Asc(deadletter.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return report, NewStorageError("dead letter lookup", err)
+		}
+		if len(letters) == 0 {
+			return report, nil
+		}
+		lastID = letters[len(letters)-1].ID
+
+		for _, letter := range letters {
+			stored, reprocessErr := s.reprocess(ctx, letter)
+			if IsStorageError(reprocessErr) {
+				// The database is unavailable, the letter itself may be fine
+				return report, reprocessErr
+			}
+			if err := s.settleDeadLetter(ctx, letter, reprocessErr); err != nil {
+				return report, err
+			}
+			if reprocessErr != nil {
+				report.Failed++
+				continue
+			}
+			report.Reprocessed++
+			report.Stored += stored
+		}
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+	}
+}
+
+// reprocess decodes and ingests the payload of a dead letter
+func (s *Service) reprocess(ctx context.Context, letter *ent.DeadLetter) (int, error) {
+	var events []auditv1.Event
+	switch letter.Kind {
+	case DeadLetterBody:
+		decoded, err := DecodeEvents([]byte(letter.Payload))
+		if err != nil {
+			return 0, err
+		}
+		events = decoded
+	case DeadLetterEvent:
+		decoded, err := DecodeEvent([]byte(letter.Payload))
+		if err != nil {
+			return 0, err
+		}
+		events = []auditv1.Event{*decoded}
+	default:
+		return 0, fmt.Errorf("unknown dead letter kind %q", letter.Kind)
+	}
+
+	accepted, result := s.Prepare(letter.Cluster, events)
+	stored := 0
+	if len(accepted) > 0 {
+		n, err := s.Store(ctx, accepted)
+		if err != nil {
+			return 0, err
+		}
+		stored = n
+	}
+	if len(result.Rejected) > 0 {
+		return stored, result.Rejected[0]
+	}
+	return stored, nil
+}
+
+// settleDeadLetter deletes a reprocessed dead letter, or records why it failed again
+func (s *Service) settleDeadLetter(ctx context.Context, letter *ent.DeadLetter, reprocessErr error) error {
+	var err error
+	if reprocessErr == nil {
+		err = s.client.DeadLetter.DeleteOneID(letter.ID).Exec(ctx)
+	} else {
+		err = s.client.DeadLetter.UpdateOneID(letter.ID).
+			SetReason(reprocessErr.Error()).
+			AddAttempts(1).
+			Exec(ctx)
+	}
+	if err != nil {
+		return NewStorageError("dead letter update", fmt.Errorf("dead letter %d: %w", letter.ID, err))
+	}
+	return nil
+}
+
+// ReencryptDeadLetters rewrites the payload of dead letters not encrypted with
+// the primary key of the configured keyring, like ReencryptRaw does for events
+func ReencryptDeadLetters(ctx context.Context, client *ent.Client) (int, error) {
+	keyID := rawcodec.KeyID()
+	if keyID == "" {
+		return 0, errors.New("raw encryption is disabled")
+	}
+	letters, err := client.DeadLetter.Query().
+		Where(deadletter.PayloadKeyIDNEQ(keyID)).
+		Select(deadletter.FieldID, deadletter.FieldPayload).
+		All(ctx)
+	if err != nil {
+		return 0, NewStorageError("reencrypt dead letters", err)
+	}
+	for i, letter := range letters {
+		err := client.DeadLetter.UpdateOneID(letter.ID).
+			SetPayload(letter.Payload).
+			SetPayloadKeyID(keyID).
+			Exec(ctx)
+		if err != nil {
+			return i, NewStorageError("reencrypt dead letters", fmt.Errorf("dead letter %d: %w", letter.ID, err))
+		}
+	}
+	return len(letters), nil
+}
@@ -0,0 +1,116 @@
+package ingestion_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/deadletter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+func TestDeadLetters(t *testing.T) {
+	t.Run("should keep undecodable bodies and rejected events", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters()))
+
+		recorder, _ := postWebhook(t, router, `{"items": [`)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		invalid := newTestEvent("b", "create")
+		invalid.Level = ""
+		recorder, _ = postWebhook(t, router, eventListBody(t, newTestEvent("a", "create"), invalid))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		body := client.DeadLetter.Query().Where(deadletter.Kind(ingestion.DeadLetterBody)).OnlyX(ctx)
+		assert.Equal(t, `{"items": [`, body.Payload)
+		assert.Equal(t, ingestion.DefaultCluster, body.Cluster)
+		assert.NotEmpty(t, body.Reason)
+		assert.Zero(t, body.Attempts)
+
+		event := client.DeadLetter.Query().Where(deadletter.Kind(ingestion.DeadLetterEvent)).OnlyX(ctx)
+		assert.Contains(t, event.Payload, `"auditID":"b"`)
+		assert.Contains(t, event.Reason, "level")
+		assert.Equal(t, ingestion.DefaultCluster, event.Cluster)
+		assert.Equal(t, 1, client.AuditEvent.Query().CountX(ctx))
+	})
+
+	t.Run("should not keep payloads unless enabled", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		postWebhook(t, router, `{"items": [`)
+		assert.Zero(t, client.DeadLetter.Query().CountX(context.Background()))
+	})
+
+	t.Run("should keep the rejected events but not the bodies without dead letter bodies", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters(), ingestion.WithoutDeadLetterBodies()))
+
+		recorder, _ := postWebhook(t, router, `{"items": [{"kind": "Secret", "data": {"token": "c2VjcmV0"}`)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Zero(t, client.DeadLetter.Query().Where(deadletter.Kind(ingestion.DeadLetterBody)).CountX(ctx))
+
+		invalid := newTestEvent("b", "create")
+		invalid.Level = ""
+		postWebhook(t, router, eventListBody(t, invalid))
+		assert.Equal(t, 1, client.DeadLetter.Query().Where(deadletter.Kind(ingestion.DeadLetterEvent)).CountX(ctx))
+	})
+
+	t.Run("should keep the metadata of rejected events that cannot be encoded", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters(), ingestion.WithProcessors(corruptPatches{})))
+
+		recorder, response := postWebhook(t, router, eventListBody(t, newTestEvent("a", "create"), newTestEvent("b", "patch")))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		require.Len(t, response.Result.Rejected, 1)
+		assert.Equal(t, "b", response.Result.Rejected[0].AuditID)
+
+		event := client.DeadLetter.Query().Where(deadletter.Kind(ingestion.DeadLetterEvent)).OnlyX(ctx)
+		assert.Contains(t, event.Payload, `"auditID":"b"`)
+		assert.NotContains(t, event.Payload, "requestObject")
+		assert.Contains(t, event.Reason, "raw")
+	})
+
+	t.Run("should delete reprocessed letters and count the failing ones", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		service := ingestion.NewService(client, ingestion.WithDeadLetters())
+
+		// A body the parser could not read before it was fixed
+		fixed := client.DeadLetter.Create().
+			SetKind(ingestion.DeadLetterBody).
+			SetCluster("staging").
+			SetReason("failed to decode audit events").
+			SetPayload(eventListBody(t, newTestEvent("a", "create"), newTestEvent("b", "delete"))).
+			SaveX(ctx)
+		broken := client.DeadLetter.Create().
+			SetKind(ingestion.DeadLetterBody).
+			SetReason("failed to decode audit events").
+			SetPayload(`{"items": [`).
+			SaveX(ctx)
+
+		report, err := service.ReprocessDeadLetters(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, &ingestion.ReprocessReport{Reprocessed: 1, Failed: 1, Stored: 2}, report)
+
+		assert.False(t, client.DeadLetter.Query().Where(deadletter.ID(fixed.ID)).ExistX(ctx))
+		assert.Equal(t, 2, client.AuditEvent.Query().CountX(ctx))
+		assert.Equal(t, "staging", client.AuditEvent.Query().FirstX(ctx).Cluster)
+
+		broken = client.DeadLetter.GetX(ctx, broken.ID)
+		assert.Equal(t, 1, broken.Attempts)
+		assert.Contains(t, broken.Reason, "failed to decode")
+
+		// Only the selected letters are reprocessed
+		report, err = service.ReprocessDeadLetters(ctx, 0, deadletter.Kind(ingestion.DeadLetterEvent))
+		require.NoError(t, err)
+		assert.Zero(t, report.Failed)
+		assert.Equal(t, 1, client.DeadLetter.GetX(ctx, broken.ID).Attempts)
+	})
+}
@@ -0,0 +1,94 @@
+package ingestion
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+)
+
+// lookupChunkSize bounds the number of bind parameters of a single duplicate lookup
+const lookupChunkSize = 500
+
+type eventKey struct {
+	auditID string
+	stage   string
+}
+
+// filterDuplicates drops events that are already stored, as well as events repeated within the batch
+func (s *Service) filterDuplicates(ctx context.Context, entries []Entry) ([]Entry, error) {
+	seen := make(map[eventKey]struct{}, len(entries))
+	for start := 0; start < len(entries); start += lookupChunkSize {
+		end := min(start+lookupChunkSize, len(entries))
+		auditIDs := make([]string, 0, end-start)
+		for _, entry := range entries[start:end] {
+			auditIDs = append(auditIDs, string(entry.Event.AuditID))
+		}
+
+		var rows []struct {
+			AuditID string `json:"audit_id"`
+			Stage   string `json:"stage"`
+		}
+		err := s.client.AuditEvent.Query().
+			Where(auditevent.AuditIDIn(auditIDs...)).
+			Select(auditevent.FieldAuditID, auditevent.FieldStage).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			seen[eventKey{auditID: row.AuditID, stage: row.Stage}] = struct{}{}
+		}
+	}
+
+	fresh := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		key := eventKey{auditID: string(entry.Event.AuditID), stage: string(entry.Event.Stage)}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		fresh = append(fresh, entry)
+	}
+	return fresh, nil
+}
+
+// duplicateRowsCondition selects every row but the oldest of each (auditID, stage) group.
+// The derived table keeps the statement valid on MySQL, which cannot reference the
+// table being deleted from in a plain subquery.
+var duplicateRowsCondition = fmt.Sprintf(
+	"%[1]s NOT IN (SELECT keep_id FROM (SELECT MIN(%[1]s) AS keep_id FROM %[2]s GROUP BY %[3]s, %[4]s) AS keep)",
+	auditevent.FieldID, auditevent.Table, auditevent.FieldAuditID, auditevent.FieldStage,
+)
+
+// CountDuplicates returns how many stored events repeat an earlier (auditID, stage) pair
+func CountDuplicates(ctx context.Context, drv dialect.Driver) (int64, error) {
+	rows := &entsql.Rows{}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", auditevent.Table, duplicateRowsCondition)
+	if err := drv.Query(ctx, query, []any{}, rows); err != nil {
+		return 0, NewStorageError("count duplicates", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, NewStorageError("count duplicates", err)
+		}
+	}
+	return count, rows.Err()
+}
+
+// RemoveDuplicates deletes every stored event but the oldest of each (auditID, stage) pair.
+// It works on the raw driver so it can run before the unique index has been created.
+func RemoveDuplicates(ctx context.Context, drv dialect.Driver) (int64, error) {
+	var result sql.Result
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s", auditevent.Table, duplicateRowsCondition)
+	if err := drv.Exec(ctx, query, []any{}, &result); err != nil {
+		return 0, NewStorageError("remove duplicates", err)
+	}
+	return result.RowsAffected()
+}
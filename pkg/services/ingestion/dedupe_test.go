@@ -0,0 +1,96 @@
+package ingestion_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func TestServiceStoreSkipsDuplicates(t *testing.T) {
+	t.Run("should skip events already stored for the same auditID and stage", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+
+		stored, err := service.Store(ctx, entries(newTestEvents(3)...))
+		require.NoError(t, err)
+		assert.Equal(t, 3, stored)
+
+		// A retried batch overlapping the first one
+		stored, err = service.Store(ctx, entries(newTestEvents(5)...))
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored)
+
+		count, err := client.AuditEvent.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 5, count)
+	})
+
+	t.Run("should keep different stages of the same request", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+
+		received := newTestEvent("same", "create")
+		received.Stage = auditv1.StageRequestReceived
+		complete := newTestEvent("same", "create")
+
+		stored, err := service.Store(ctx, entries(received, complete, complete))
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored)
+	})
+}
+
+func TestRemoveDuplicates(t *testing.T) {
+	t.Run("should keep only the oldest row of each auditID and stage", func(t *testing.T) {
+		ctx := context.Background()
+		dsn := fmt.Sprintf("file:dedupe_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63())
+		drv, err := entsql.Open(dialect.SQLite, dsn)
+		require.NoError(t, err)
+		client := ent.NewClient(ent.Driver(drv))
+		defer client.Close()
+		require.NoError(t, client.Schema.Create(ctx))
+
+		// Simulate a database created before the unique index existed
+		require.NoError(t, drv.Exec(ctx, "DROP INDEX auditevent_audit_id_stage", []any{}, nil))
+		for _, auditID := range []string{"a", "a", "a", "b", "c", "c"} {
+			event := newTestEvent(auditID, "create")
+			_, err := client.AuditEvent.Create().
+				SetRaw("{}").
+				SetLevel(string(event.Level)).
+				SetAuditID(auditID).
+				SetVerb(event.Verb).
+				SetUserAgent(event.UserAgent).
+				SetRequestTimestamp(event.RequestReceivedTimestamp.Time).
+				SetStageTimestamp(event.StageTimestamp.Time).
+				SetStage(string(event.Stage)).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+
+		count, err := ingestion.CountDuplicates(ctx, drv)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		removed, err := ingestion.RemoveDuplicates(ctx, drv)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), removed)
+
+		remaining, err := client.AuditEvent.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, remaining)
+
+		// The unique index can now be created
+		require.NoError(t, client.Schema.Create(ctx))
+	})
+}
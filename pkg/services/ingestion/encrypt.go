@@ -0,0 +1,98 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+// DefaultReencryptBatchSize is the number of rows rewritten per transaction by ReencryptRaw
+const DefaultReencryptBatchSize = 500
+
+// ReencryptRaw rewrites the raw payload of rows not encrypted with the primary
+// key of the configured keyring, including rows stored unencrypted. It returns
+// the number of rewritten rows and is safe to interrupt and run again.
+func ReencryptRaw(ctx context.Context, client *ent.Client, batchSize int) (int, error) {
+	keyID := rawcodec.KeyID()
+	if keyID == "" {
+		return 0, errors.New("raw encryption is disabled")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultReencryptBatchSize
+	}
+
+	reencrypted, lastID := 0, 0
+	for {
+		rows, err := client.AuditEvent.Query().
+			Where(auditevent.RawKeyIDNEQ(keyID), auditevent.IDGT(lastID)).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(batchSize).
+			Select(auditevent.FieldID, auditevent.FieldRaw).
+			All(ctx)
+		if err != nil {
+			return reencrypted, NewStorageError("reencrypt raw", err)
+		}
+		if len(rows) == 0 {
+			return reencrypted, nil
+		}
+		lastID = rows[len(rows)-1].ID
+
+		if err := reencryptRows(ctx, client, rows, keyID); err != nil {
+			return reencrypted, err
+		}
+		reencrypted += len(rows)
+		if ctx.Err() != nil {
+			return reencrypted, ctx.Err()
+		}
+	}
+}
+
+func reencryptRows(ctx context.Context, client *ent.Client, rows []*ent.AuditEvent, keyID string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return NewStorageError("reencrypt raw", err)
+	}
+	for _, row := range rows {
+		// The payload is compressed again as configured, which keeps rawSize accurate
+		rawSize := 0
+		if rawcodec.Enabled() {
+			rawSize = len(row.Raw)
+		}
+		err := tx.AuditEvent.UpdateOneID(row.ID).
+			SetRaw(row.Raw).
+			SetRawSize(rawSize).
+			SetRawKeyID(keyID).
+			Exec(ctx)
+		if err != nil {
+			return NewStorageError("reencrypt raw", fmt.Errorf("event %d: %w", row.ID, errors.Join(err, tx.Rollback())))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return NewStorageError("reencrypt raw", err)
+	}
+	return nil
+}
+
+// CountRawKeys returns the number of rows encrypted with each key, under an empty ID for unencrypted rows
+func CountRawKeys(ctx context.Context, client *ent.Client) (map[string]int, error) {
+	var groups []struct {
+		RawKeyID string `json:"raw_key_id"`
+		Count    int    `json:"count"`
+	}
+	err := client.AuditEvent.Query().
+		GroupBy(auditevent.FieldRawKeyID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, NewStorageError("count raw keys", err)
+	}
+	counts := make(map[string]int, len(groups))
+	for _, group := range groups {
+		counts[group.RawKeyID] = group.Count
+	}
+	return counts, nil
+}
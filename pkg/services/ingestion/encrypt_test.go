@@ -0,0 +1,60 @@
+package ingestion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func TestReencryptRaw(t *testing.T) {
+	t.Run("should encrypt plain rows, then rotate them to a new key", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		t.Cleanup(func() { require.NoError(t, rawcodec.Configure(rawcodec.DefaultOptions())) })
+
+		_, err := ingestion.NewService(client).Ingest(ctx, "", []auditv1.Event{newUserEvent("a"), newUserEvent("b")})
+		require.NoError(t, err)
+		_, err = ingestion.ReencryptRaw(ctx, client, 1)
+		assert.Error(t, err, "encryption is disabled")
+
+		keyring := &rawcodec.Keyring{}
+		first, err := keyring.Generate()
+		require.NoError(t, err)
+		require.NoError(t, rawcodec.Configure(rawcodec.Options{Keyring: keyring}))
+		_, err = ingestion.NewService(client).Ingest(ctx, "", []auditv1.Event{newUserEvent("c")})
+		require.NoError(t, err)
+
+		counts, err := ingestion.CountRawKeys(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"": 2, first.ID: 1}, counts)
+
+		reencrypted, err := ingestion.ReencryptRaw(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, reencrypted)
+
+		keyring.Keys = append(keyring.Keys, rawcodec.Key{ID: "second", Secret: make([]byte, rawcodec.KeySize)})
+		keyring.Primary = "second"
+		require.NoError(t, rawcodec.Configure(rawcodec.Options{Keyring: keyring}))
+		reencrypted, err = ingestion.ReencryptRaw(ctx, client, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reencrypted)
+
+		counts, err = ingestion.CountRawKeys(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"second": 3}, counts)
+
+		// Only the new key is needed to read the rows now
+		keyring.Keys = keyring.Keys[1:]
+		require.NoError(t, rawcodec.Configure(rawcodec.Options{Keyring: keyring}))
+		stored, err := client.AuditEvent.Query().Where(auditevent.AuditID("a")).Only(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, stored.Raw, `"username":"alice"`)
+		assert.Positive(t, stored.RawSize)
+	})
+}
@@ -0,0 +1,113 @@
+package ingestion
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Common errors
+var (
+	// ErrEmptyBody indicates that the webhook request carried no payload
+	ErrEmptyBody = errors.New("empty request body")
+	// ErrBodyTooLarge indicates that the webhook request body, or its decompressed content, exceeds the maximum body size
+	ErrBodyTooLarge = errors.New("request body too large")
+)
+
+// ValidationError represents an audit event that is missing required data
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Message)
+}
+
+// NewValidationError creates a new validation error
+func NewValidationError(field, message string) *ValidationError {
+	return &ValidationError{
+		Field:   field,
+		Message: message,
+	}
+}
+
+// DecodeError wraps failures to decode an incoming payload
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode audit events: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// NewDecodeError creates a new decode error
+func NewDecodeError(err error) *DecodeError {
+	return &DecodeError{Err: err}
+}
+
+// StorageError wraps database failures while persisting a batch
+type StorageError struct {
+	Operation string
+	Err       error
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("storage error during %s: %v", e.Operation, e.Err)
+}
+
+func (e *StorageError) Unwrap() error {
+	return e.Err
+}
+
+// NewStorageError creates a new storage error
+func NewStorageError(operation string, err error) *StorageError {
+	return &StorageError{
+		Operation: operation,
+		Err:       err,
+	}
+}
+
+// EventError reports a single event of a batch that was not ingested
+type EventError struct {
+	Index   int    `json:"index"`
+	AuditID string `json:"auditID,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+func (e *EventError) Error() string {
+	if e.AuditID == "" {
+		return fmt.Sprintf("event %d rejected: %s", e.Index, e.Reason)
+	}
+	return fmt.Sprintf("event %d (auditID %s) rejected: %s", e.Index, e.AuditID, e.Reason)
+}
+
+// RejectedError reports the entries Store could not encode, the other entries being stored
+type RejectedError struct {
+	Rejected []*EventError
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("%d audit events could not be encoded", len(e.Rejected))
+}
+
+// IsValidationError checks if an error is a validation error
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
+
+// IsDecodeError checks if an error is a decode error
+func IsDecodeError(err error) bool {
+	var decodeErr *DecodeError
+	return errors.As(err, &decodeErr)
+}
+
+// IsStorageError checks if an error is a storage error
+func IsStorageError(err error) bool {
+	var storageErr *StorageError
+	return errors.As(err, &storageErr)
+}
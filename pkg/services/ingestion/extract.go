@@ -0,0 +1,35 @@
+package ingestion
+
+import (
+	"strings"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// setExtractedFields copies the user, source and response details of the event
+// into their queryable columns
+func setExtractedFields(m *ent.AuditEventMutation, event *auditv1.Event) {
+	m.SetUsername(event.User.Username)
+	if len(event.User.Groups) > 0 {
+		m.SetUserGroups(event.User.Groups)
+	}
+	if len(event.SourceIPs) > 0 {
+		m.SetSourceIPs(event.SourceIPs)
+	}
+	if event.ResponseStatus != nil {
+		m.SetResponseCode(int(event.ResponseStatus.Code))
+	}
+	if event.ImpersonatedUser != nil {
+		m.SetImpersonatedUser(event.ImpersonatedUser.Username)
+	}
+	m.SetUserAgentName(ExtractUserAgentName(event.UserAgent))
+}
+
+// ExtractUserAgentName returns the product of a user agent, e.g. "kubectl" for
+// "kubectl/v1.30.0 (linux/amd64) kubernetes/7c48c2b"
+func ExtractUserAgentName(userAgent string) string {
+	product, _, _ := strings.Cut(strings.TrimSpace(userAgent), " ")
+	name, _, _ := strings.Cut(product, "/")
+	return name
+}
@@ -0,0 +1,112 @@
+package ingestion
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics are the Prometheus collectors of the ingestion pipeline. A nil
+// *Metrics records nothing, so that commands without /metrics skip them.
+type Metrics struct {
+	events         *prometheus.CounterVec
+	batches        *prometheus.CounterVec
+	decodeFailures prometheus.Counter
+	ingestLatency  prometheus.Histogram
+	writeDuration  prometheus.Histogram
+}
+
+// NewMetrics creates the ingestion collectors and registers them
+func NewMetrics(registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "kad",
+			Subsystem: "ingestion",
+			Name:      "events_total",
+			Help:      "Audit events received, by verb, level, stage and result: accepted, rejected or dropped.",
+		}, []string{"verb", "level", "stage", "result"}),
+		batches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "kad",
+			Subsystem: "ingestion",
+			Name:      "batches_total",
+			Help:      "Batches of audit events written to the database, by result: stored or failed.",
+		}, []string{"result"}),
+		decodeFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "kad",
+			Subsystem: "ingestion",
+			Name:      "decode_failures_total",
+			Help:      "Webhook request bodies that could not be decoded.",
+		}),
+		ingestLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "kad",
+			Subsystem: "ingestion",
+			Name:      "latency_seconds",
+			Help:      "Time between the stage timestamp of an audit event and its storage.",
+			Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+		}),
+		writeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "kad",
+			Subsystem: "database",
+			Name:      "write_duration_seconds",
+			Help:      "Duration of the transactions writing a batch of audit events.",
+			Buckets:   prometheus.ExponentialBuckets(.001, 2, 14),
+		}),
+	}
+	registerer.MustRegister(m.events, m.batches, m.decodeFailures, m.ingestLatency, m.writeDuration)
+	return m
+}
+
+// WithMetrics records the activity of the service in the collectors
+func WithMetrics(metrics *Metrics) Option {
+	return func(s *Service) {
+		s.metrics = metrics
+	}
+}
+
+// RegisterQueueMetrics exposes the state of the queue
+func RegisterQueueMetrics(registerer prometheus.Registerer, queue *Queue) {
+	gauge := func(name, help string, value func(QueueStats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Namespace: "kad", Subsystem: "ingestion_queue", Name: name, Help: help},
+			func() float64 { return value(queue.Stats()) })
+	}
+	counter := func(name, help string, value func(QueueStats) float64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{Namespace: "kad", Subsystem: "ingestion_queue", Name: name, Help: help},
+			func() float64 { return value(queue.Stats()) })
+	}
+	registerer.MustRegister(
+		gauge("depth", "Audit events buffered or being written.", func(s QueueStats) float64 { return float64(s.Depth) }),
+		gauge("capacity", "Maximum number of audit events buffered.", func(s QueueStats) float64 { return float64(s.Capacity) }),
+		counter("throttled_total", "Webhook requests refused because the queue was full.", func(s QueueStats) float64 { return float64(s.Throttled) }),
+		counter("failed_total", "Audit events dropped after their batch failed every retry.", func(s QueueStats) float64 { return float64(s.Failed) }),
+	)
+}
+
+func (m *Metrics) recordEvent(verb, level, stage, result string) {
+	if m != nil {
+		m.events.WithLabelValues(verb, level, stage, result).Inc()
+	}
+}
+
+func (m *Metrics) recordBatch(entries []Entry, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.writeDuration.Observe(duration.Seconds())
+	if err != nil {
+		m.batches.WithLabelValues("failed").Inc()
+		return
+	}
+	m.batches.WithLabelValues("stored").Inc()
+	now := time.Now()
+	for i := range entries {
+		// The clock of the apiserver may be ahead of ours
+		latency := max(now.Sub(entries[i].Event.StageTimestamp.Time), 0)
+		m.ingestLatency.Observe(latency.Seconds())
+	}
+}
+
+func (m *Metrics) recordDecodeFailure() {
+	if m != nil {
+		m.decodeFailures.Inc()
+	}
+}
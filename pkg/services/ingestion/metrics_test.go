@@ -0,0 +1,65 @@
+package ingestion_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+type dropDeletes struct{}
+
+func (dropDeletes) Process(entry *ingestion.Entry) bool {
+	return entry.Event.Verb != "delete"
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("should count events by result and written batches", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+		service := ingestion.NewService(setupTestDB(t),
+			ingestion.WithMetrics(ingestion.NewMetrics(registry)),
+			ingestion.WithProcessors(dropDeletes{}))
+
+		invalid := newTestEvent("c", "get")
+		invalid.UserAgent = ""
+		_, err := service.Ingest(context.Background(), "", []auditv1.Event{
+			newTestEvent("a", "create"), newTestEvent("b", "delete"), invalid,
+		})
+		require.NoError(t, err)
+
+		err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP kad_ingestion_events_total Audit events received, by verb, level, stage and result: accepted, rejected or dropped.
+# TYPE kad_ingestion_events_total counter
+kad_ingestion_events_total{level="RequestResponse",result="accepted",stage="ResponseComplete",verb="create"} 1
+kad_ingestion_events_total{level="RequestResponse",result="dropped",stage="ResponseComplete",verb="delete"} 1
+kad_ingestion_events_total{level="RequestResponse",result="rejected",stage="ResponseComplete",verb="get"} 1
+# HELP kad_ingestion_batches_total Batches of audit events written to the database, by result: stored or failed.
+# TYPE kad_ingestion_batches_total counter
+kad_ingestion_batches_total{result="stored"} 1
+`), "kad_ingestion_events_total", "kad_ingestion_batches_total")
+		assert.NoError(t, err)
+
+		count, err := testutil.GatherAndCount(registry, "kad_ingestion_latency_seconds", "kad_database_write_duration_seconds")
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("should expose the queue state", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+		queue := ingestion.NewQueue(ingestion.NewService(setupTestDB(t)), ingestion.QueueOptions{Capacity: 7})
+		ingestion.RegisterQueueMetrics(registry, queue)
+
+		err := testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP kad_ingestion_queue_capacity Maximum number of audit events buffered.
+# TYPE kad_ingestion_queue_capacity gauge
+kad_ingestion_queue_capacity 7
+`), "kad_ingestion_queue_capacity")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,311 @@
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrQueueFull indicates that the queue cannot buffer the submitted events
+	ErrQueueFull = errors.New("ingestion queue is full")
+
+	// ErrQueueClosed indicates that the queue no longer accepts events
+	ErrQueueClosed = errors.New("ingestion queue is closed")
+)
+
+// QueueOptions configures the asynchronous ingestion queue
+type QueueOptions struct {
+	// Capacity is the maximum number of events buffered or being written
+	Capacity int
+	// BatchSize is the maximum number of events written in one bulk insert
+	BatchSize int
+	// FlushInterval is the longest time an event waits for its batch to fill up
+	FlushInterval time.Duration
+	// Workers is the number of concurrent writers
+	Workers int
+	// MaxRetries is how many times a failed batch is retried before it is dropped
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled on every attempt
+	RetryBackoff time.Duration
+}
+
+// DefaultQueueOptions returns the options used when nothing is configured
+func DefaultQueueOptions() QueueOptions {
+	return QueueOptions{
+		Capacity:      10000,
+		BatchSize:     500,
+		FlushInterval: time.Second,
+		Workers:       2,
+		MaxRetries:    3,
+		RetryBackoff:  500 * time.Millisecond,
+	}
+}
+
+// QueueStats is a point-in-time snapshot of the queue state
+type QueueStats struct {
+	Depth            int64   `json:"depth"`
+	Capacity         int     `json:"capacity"`
+	Enqueued         int64   `json:"enqueued"`
+	Throttled        int64   `json:"throttled"`
+	Batches          int64   `json:"batches"`
+	Stored           int64   `json:"stored"`
+	Failed           int64   `json:"failed"`
+	Duplicates       int64   `json:"duplicates"`
+	LastFlushSeconds float64 `json:"lastFlushSeconds"`
+	MaxFlushSeconds  float64 `json:"maxFlushSeconds"`
+}
+
+// Queue buffers validated events in memory and writes them in batches from
+// a pool of workers, so that webhook requests do not wait on the database.
+type Queue struct {
+	service *Service
+	opts    QueueOptions
+	logger  *slog.Logger
+
+	entries chan Entry
+	wg      sync.WaitGroup
+	// ctx is cancelled when Stop gives up waiting, interrupting the writes and their retries
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.RWMutex
+	closed bool
+
+	// pending counts events accepted but not yet written, bounding memory usage
+	pending    atomic.Int64
+	enqueued   atomic.Int64
+	throttled  atomic.Int64
+	batches    atomic.Int64
+	stored     atomic.Int64
+	failed     atomic.Int64
+	duplicates atomic.Int64
+	lastFlush  atomic.Int64
+	maxFlush   atomic.Int64
+}
+
+// NewQueue creates a queue writing through the given service. Zero option
+// values are replaced by their defaults.
+func NewQueue(service *Service, opts QueueOptions) *Queue {
+	defaults := DefaultQueueOptions()
+	if opts.Capacity <= 0 {
+		opts.Capacity = defaults.Capacity
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = defaults.FlushInterval
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = defaults.Workers
+	}
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = defaults.RetryBackoff
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Queue{
+		service: service,
+		opts:    opts,
+		logger:  slog.Default().With("component", "ingestion-queue"),
+		entries: make(chan Entry, opts.Capacity),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Start launches the writer workers
+func (q *Queue) Start() {
+	for i := 0; i < q.opts.Workers; i++ {
+		q.wg.Add(1)
+		go q.worker()
+	}
+}
+
+// Submit enqueues all entries or none of them. ErrQueueFull is returned when
+// the remaining capacity is too small for the whole batch. A batch larger than
+// the capacity is only accepted while the queue is empty, so that it is not
+// refused forever.
+func (q *Queue) Submit(_ context.Context, entries []Entry) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	n := int64(len(entries))
+	for {
+		pending := q.pending.Load()
+		if pending > 0 && pending+n > int64(q.opts.Capacity) {
+			q.throttled.Add(n)
+			return ErrQueueFull
+		}
+		if q.pending.CompareAndSwap(pending, pending+n) {
+			break
+		}
+	}
+
+	// Capacity has been reserved, so these sends only block while the workers
+	// drain a batch larger than the buffer
+	for _, entry := range entries {
+		q.entries <- entry
+	}
+	q.enqueued.Add(n)
+	return nil
+}
+
+// Stop stops accepting events and waits until buffered events are written.
+// When the context expires first, the writes in progress are cancelled and the
+// remaining events dropped; Stop returns once no worker uses the service anymore.
+func (q *Queue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.entries)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// Ready returns ErrQueueClosed once the queue is stopped and ErrQueueFull while
+// it cannot accept events
+func (q *Queue) Ready() error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+	if q.pending.Load() >= int64(q.opts.Capacity) {
+		return ErrQueueFull
+	}
+	return nil
+}
+
+// Stats returns a snapshot of the queue counters
+func (q *Queue) Stats() QueueStats {
+	return QueueStats{
+		Depth:            q.pending.Load(),
+		Capacity:         q.opts.Capacity,
+		Enqueued:         q.enqueued.Load(),
+		Throttled:        q.throttled.Load(),
+		Batches:          q.batches.Load(),
+		Stored:           q.stored.Load(),
+		Failed:           q.failed.Load(),
+		Duplicates:       q.duplicates.Load(),
+		LastFlushSeconds: time.Duration(q.lastFlush.Load()).Seconds(),
+		MaxFlushSeconds:  time.Duration(q.maxFlush.Load()).Seconds(),
+	}
+}
+
+// RetryAfter suggests how long a throttled client should wait before retrying
+func (q *Queue) RetryAfter() time.Duration {
+	if q.opts.FlushInterval < time.Second {
+		return time.Second
+	}
+	return q.opts.FlushInterval
+}
+
+func (q *Queue) worker() {
+	defer q.wg.Done()
+
+	batch := make([]Entry, 0, q.opts.BatchSize)
+	ticker := time.NewTicker(q.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case entry, ok := <-q.entries:
+			if !ok {
+				q.flush(batch)
+				return
+			}
+			batch = append(batch, entry)
+			if len(batch) >= q.opts.BatchSize {
+				q.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				q.flush(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
+
+// flush writes a batch, retrying transient storage failures with exponential backoff
+func (q *Queue) flush(batch []Entry) {
+	if len(batch) == 0 {
+		return
+	}
+	defer q.pending.Add(-int64(len(batch)))
+
+	start := time.Now()
+	backoff := q.opts.RetryBackoff
+	var stored int
+	var err error
+	for attempt := 0; attempt <= q.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-q.ctx.Done():
+			}
+			backoff *= 2
+		}
+		// Retrying is safe: rows stored by a partially failed attempt are skipped as duplicates
+		var n int
+		n, err = q.service.Store(q.ctx, batch)
+		stored += n
+		if err == nil || !IsStorageError(err) || q.ctx.Err() != nil {
+			break
+		}
+		q.logger.Warn("retrying failed batch", "attempt", attempt+1, "count", len(batch), "error", err)
+	}
+	q.recordFlush(time.Since(start))
+	q.batches.Add(1)
+
+	q.stored.Add(int64(stored))
+	var rejected *RejectedError
+	if errors.As(err, &rejected) {
+		// The other events were stored
+		q.failed.Add(int64(len(rejected.Rejected)))
+		q.duplicates.Add(int64(len(batch) - stored - len(rejected.Rejected)))
+		return
+	}
+	if err != nil {
+		q.failed.Add(int64(len(batch) - stored))
+		q.logger.Error("dropping batch after retries", "count", len(batch)-stored, "error", err)
+		return
+	}
+	q.duplicates.Add(int64(len(batch) - stored))
+}
+
+func (q *Queue) recordFlush(latency time.Duration) {
+	q.lastFlush.Store(int64(latency))
+	for {
+		current := q.maxFlush.Load()
+		if int64(latency) <= current || q.maxFlush.CompareAndSwap(current, int64(latency)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package ingestion_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func newTestEvents(n int) []auditv1.Event {
+	events := make([]auditv1.Event, 0, n)
+	for i := 0; i < n; i++ {
+		events = append(events, newTestEvent(fmt.Sprintf("event-%d", i), "create"))
+	}
+	return events
+}
+
+// entries attributes the events to the default cluster
+func entries(events ...auditv1.Event) []ingestion.Entry {
+	result := make([]ingestion.Entry, 0, len(events))
+	for _, event := range events {
+		result = append(result, ingestion.Entry{Event: event, Cluster: ingestion.DefaultCluster})
+	}
+	return result
+}
+
+func TestQueue(t *testing.T) {
+	t.Run("should write submitted events in batches", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		queue := ingestion.NewQueue(ingestion.NewService(client), ingestion.QueueOptions{
+			Capacity:      100,
+			BatchSize:     4,
+			FlushInterval: 10 * time.Millisecond,
+			Workers:       2,
+		})
+		queue.Start()
+
+		require.NoError(t, queue.Submit(ctx, entries(newTestEvents(10)...)))
+		require.NoError(t, queue.Stop(ctx))
+
+		count, err := client.AuditEvent.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 10, count)
+
+		stats := queue.Stats()
+		assert.Equal(t, int64(0), stats.Depth)
+		assert.Equal(t, int64(10), stats.Enqueued)
+		assert.Equal(t, int64(10), stats.Stored)
+		assert.GreaterOrEqual(t, stats.Batches, int64(3))
+	})
+
+	t.Run("should reject whole batches that exceed the remaining capacity", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		queue := ingestion.NewQueue(ingestion.NewService(client), ingestion.QueueOptions{Capacity: 5})
+
+		require.NoError(t, queue.Submit(ctx, entries(newTestEvents(3)...)))
+		assert.NoError(t, queue.Ready())
+		assert.ErrorIs(t, queue.Submit(ctx, entries(newTestEvents(3)...)), ingestion.ErrQueueFull)
+		require.NoError(t, queue.Submit(ctx, entries(newTestEvents(2)...)))
+		assert.ErrorIs(t, queue.Ready(), ingestion.ErrQueueFull)
+		assert.Equal(t, int64(5), queue.Stats().Depth)
+		assert.Equal(t, int64(3), queue.Stats().Throttled)
+	})
+
+	t.Run("should accept a batch larger than the capacity only while empty", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		queue := ingestion.NewQueue(ingestion.NewService(client), ingestion.QueueOptions{Capacity: 2, FlushInterval: 10 * time.Millisecond})
+		queue.Start()
+
+		require.NoError(t, queue.Submit(ctx, entries(newTestEvents(5)...)))
+		assert.ErrorIs(t, queue.Submit(ctx, entries(newTestEvents(1)...)), ingestion.ErrQueueFull)
+		require.NoError(t, queue.Stop(ctx))
+		assert.Equal(t, int64(5), queue.Stats().Stored)
+	})
+
+	t.Run("should refuse events after stop", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		queue := ingestion.NewQueue(ingestion.NewService(client), ingestion.QueueOptions{})
+		queue.Start()
+		require.NoError(t, queue.Stop(ctx))
+
+		assert.ErrorIs(t, queue.Ready(), ingestion.ErrQueueClosed)
+		assert.ErrorIs(t, queue.Submit(ctx, entries(newTestEvents(1)...)), ingestion.ErrQueueClosed)
+	})
+
+	t.Run("should cancel the retries of failing batches when stop times out", func(t *testing.T) {
+		client := setupTestDB(t)
+		require.NoError(t, client.Close())
+		queue := ingestion.NewQueue(ingestion.NewService(client), ingestion.QueueOptions{
+			FlushInterval: 10 * time.Millisecond,
+			Workers:       1,
+			MaxRetries:    5,
+			RetryBackoff:  time.Hour,
+		})
+		queue.Start()
+		require.NoError(t, queue.Submit(context.Background(), entries(newTestEvents(3)...)))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		assert.ErrorIs(t, queue.Stop(ctx), context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		// The worker is done once Stop returns
+		stats := queue.Stats()
+		assert.Equal(t, int64(3), stats.Failed)
+		assert.Zero(t, stats.Depth)
+	})
+}
+
+func TestWebhookHandlerWithQueue(t *testing.T) {
+	t.Run("should return 429 with Retry-After when the queue is full", func(t *testing.T) {
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+		queue := ingestion.NewQueue(service, ingestion.QueueOptions{Capacity: 1})
+
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.POST("/api/audit-webhook", ingestion.NewWebhookHandler(service, queue))
+		require.NoError(t, queue.Submit(context.Background(), entries(newTestEvents(1)...)))
+
+		recorder, response := postWebhook(t, router, eventListBody(t, newTestEvents(2)...))
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+		assert.Contains(t, response.Error, "full")
+	})
+}
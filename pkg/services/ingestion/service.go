@@ -0,0 +1,416 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// Result summarizes the outcome of ingesting a batch of audit events
+type Result struct {
+	Received int `json:"received"`
+	Accepted int `json:"accepted"`
+	// Dropped is the number of valid events discarded by a processor
+	Dropped  int           `json:"dropped,omitempty"`
+	Rejected []*EventError `json:"rejected,omitempty"`
+}
+
+// Entry is an audit event together with the metadata attached during ingestion
+type Entry struct {
+	Event   auditv1.Event
+	Cluster string
+	// SampleWeight is the number of received events the entry stands for once
+	// sampled, 0 meaning 1
+	SampleWeight int
+	// raw is the event encoded by Prepare for the raw column, empty when the
+	// entry was built by hand
+	raw string
+}
+
+// Sink accepts validated audit events for storage
+type Sink interface {
+	Submit(ctx context.Context, entries []Entry) error
+}
+
+// Processor transforms a valid event before it is stored. It returns false to drop the event.
+type Processor interface {
+	Process(entry *Entry) bool
+}
+
+// Forwarder receives the events once they are stored
+type Forwarder interface {
+	Forward(entries []Entry)
+}
+
+// Option configures a Service
+type Option func(*Service)
+
+// WithDefaultCluster sets the cluster of events that are not attributed to any cluster
+func WithDefaultCluster(cluster string) Option {
+	return func(s *Service) {
+		s.defaultCluster = cluster
+	}
+}
+
+// WithProcessors runs the processors, in order, on every valid event
+func WithProcessors(processors ...Processor) Option {
+	return func(s *Service) {
+		s.processors = append(s.processors, processors...)
+	}
+}
+
+// WithForwarder hands every stored event over to the forwarder. Duplicates
+// skipped by Store are not forwarded again.
+func WithForwarder(forwarder Forwarder) Option {
+	return func(s *Service) {
+		s.forwarder = forwarder
+	}
+}
+
+// WithMaxBodySize limits the size of webhook bodies, both as received and once
+// decompressed, DefaultMaxBodySize by default
+func WithMaxBodySize(bytes int64) Option {
+	return func(s *Service) {
+		s.maxBodySize = bytes
+	}
+}
+
+// Service validates audit events and persists them as AuditEvent entities
+type Service struct {
+	client               *ent.Client
+	logger               *slog.Logger
+	defaultCluster       string
+	processors           []Processor
+	metrics              *Metrics
+	deadLetters          bool
+	skipDeadLetterBodies bool
+	forwarder            Forwarder
+	maxBodySize          int64
+}
+
+// NewService creates a new ingestion service backed by the given ent client
+func NewService(client *ent.Client, opts ...Option) *Service {
+	s := &Service{
+		client:         client,
+		logger:         slog.Default().With("component", "ingestion"),
+		defaultCluster: DefaultCluster,
+		maxBodySize:    DefaultMaxBodySize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Prepare validates every event of the batch received from the cluster, runs
+// the processors on the valid ones and encodes those kept, returning the entries
+// that may be stored and a report for each event rejected by validation or
+// encoding. An empty cluster stands for the default cluster.
+func (s *Service) Prepare(cluster string, events []auditv1.Event) ([]Entry, *Result) {
+	if cluster == "" {
+		cluster = s.defaultCluster
+	}
+	result := &Result{Received: len(events)}
+	accepted := make([]Entry, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		if err := ValidateEvent(event); err != nil {
+			s.reject(result, i, event, err)
+			s.metrics.recordEvent(event.Verb, string(event.Level), string(event.Stage), "rejected")
+			continue
+		}
+		entry := Entry{Event: *event, Cluster: cluster}
+		if !s.process(&entry) {
+			result.Dropped++
+			s.metrics.recordEvent(event.Verb, string(event.Level), string(event.Stage), "dropped")
+			continue
+		}
+		raw, err := encodeEvent(&entry.Event)
+		if err != nil {
+			s.reject(result, i, event, NewValidationError("raw", err.Error()))
+			s.metrics.recordEvent(event.Verb, string(entry.Event.Level), string(event.Stage), "rejected")
+			continue
+		}
+		entry.raw = raw
+		accepted = append(accepted, entry)
+		s.metrics.recordEvent(event.Verb, string(entry.Event.Level), string(event.Stage), "accepted")
+	}
+	result.Accepted = len(accepted)
+	return accepted, result
+}
+
+// merge adds the result of the next chunk of a batch, whose events follow those already counted
+func (r *Result) merge(next *Result) {
+	for _, rejected := range next.Rejected {
+		rejected.Index += r.Received
+		r.Rejected = append(r.Rejected, rejected)
+	}
+	r.Received += next.Received
+	r.Accepted += next.Accepted
+	r.Dropped += next.Dropped
+}
+
+// Store writes already validated events and returns how many rows were inserted.
+//
+// Events whose (auditID, stage) pair is already stored are skipped rather than
+// updated: the apiserver webhook backend retries whole batches, so a duplicate
+// is always a resend of an identical event. Entries built by hand rather than by
+// Prepare that cannot be encoded are neither stored nor forwarded, and are
+// reported by a RejectedError once the others are stored.
+func (s *Service) Store(ctx context.Context, entries []Entry) (int, error) {
+	entries, rejected := s.encode(entries)
+	stored, err := s.store(ctx, entries)
+	if err == nil && len(rejected) > 0 {
+		err = &RejectedError{Rejected: rejected}
+	}
+	return stored, err
+}
+
+// encode encodes the entries not prepared by Prepare, returning the entries that
+// can be stored and a report for each of the others, indexed in entries
+func (s *Service) encode(entries []Entry) ([]Entry, []*EventError) {
+	encoded := make([]Entry, 0, len(entries))
+	result := &Result{}
+	for i, entry := range entries {
+		if entry.raw == "" {
+			raw, err := encodeEvent(&entry.Event)
+			if err != nil {
+				event := &entry.Event
+				s.reject(result, i, event, NewValidationError("raw", err.Error()))
+				s.metrics.recordEvent(event.Verb, string(event.Level), string(event.Stage), "rejected")
+				continue
+			}
+			entry.raw = raw
+		}
+		encoded = append(encoded, entry)
+	}
+	return encoded, result.Rejected
+}
+
+// store writes encoded events, skipping the duplicates
+func (s *Service) store(ctx context.Context, entries []Entry) (int, error) {
+	fresh, err := s.filterDuplicates(ctx, entries)
+	if err != nil {
+		return 0, NewStorageError("duplicate lookup", err)
+	}
+	if skipped := len(entries) - len(fresh); skipped > 0 {
+		s.logger.Debug("skipped duplicate audit events", "count", skipped)
+	}
+
+	if len(fresh) == 0 {
+		return 0, nil
+	}
+
+	stored, err := s.storeBatch(ctx, fresh)
+	if err != nil {
+		// Another writer stored some of these events in the meantime
+		if ent.IsConstraintError(err) {
+			return s.storeEach(ctx, fresh)
+		}
+		s.logger.Error("failed to store audit events", "count", len(fresh), "error", err)
+		return 0, NewStorageError("bulk insert", err)
+	}
+	s.forward(fresh)
+	s.logger.Debug("stored audit events", "count", stored)
+	return stored, nil
+}
+
+// storeEach inserts events one by one, skipping those violating the (auditID, stage) uniqueness
+func (s *Service) storeEach(ctx context.Context, entries []Entry) (int, error) {
+	stored := 0
+	for i := range entries {
+		n, err := s.storeBatch(ctx, entries[i:i+1])
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			s.logger.Error("failed to store audit event", "error", err)
+			return stored, NewStorageError("insert", err)
+		}
+		s.forward(entries[i : i+1])
+		stored += n
+	}
+	return stored, nil
+}
+
+// storeBatch inserts the events together with their annotations in one transaction
+func (s *Service) storeBatch(ctx context.Context, entries []Entry) (stored int, err error) {
+	start := time.Now()
+	defer func() { s.metrics.recordBatch(entries, time.Since(start), err) }()
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	client := tx.Client()
+
+	builders := make([]*ent.AuditEventCreate, len(entries))
+	for i := range entries {
+		builders[i] = buildCreate(client, &entries[i])
+	}
+	events, err := client.AuditEvent.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return 0, errors.Join(err, tx.Rollback())
+	}
+	if err := storeAnnotations(ctx, client, events, entries); err != nil {
+		return 0, errors.Join(err, tx.Rollback())
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// Submit stores the events synchronously, making Service usable as a Sink
+func (s *Service) Submit(ctx context.Context, entries []Entry) error {
+	_, err := s.Store(ctx, entries)
+	return err
+}
+
+// Ingest validates and synchronously stores a batch of audit events received from the cluster.
+// Invalid events are reported in the result instead of failing the whole batch;
+// an error is only returned when the batch could not be written at all.
+func (s *Service) Ingest(ctx context.Context, cluster string, events []auditv1.Event) (*Result, error) {
+	accepted, result := s.Prepare(cluster, events)
+	if len(accepted) == 0 {
+		return result, nil
+	}
+	if _, err := s.Store(ctx, accepted); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// process runs the processors on the entry, reporting whether it is kept
+func (s *Service) process(entry *Entry) bool {
+	for _, processor := range s.processors {
+		if !processor.Process(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+// forward hands stored events over to the forwarder, if any
+func (s *Service) forward(entries []Entry) {
+	if s.forwarder != nil {
+		s.forwarder.Forward(entries)
+	}
+}
+
+// reject records a rejected event in the result
+func (s *Service) reject(result *Result, index int, event *auditv1.Event, err error) {
+	result.Rejected = append(result.Rejected, &EventError{
+		Index:   index,
+		AuditID: string(event.AuditID),
+		Reason:  err.Error(),
+	})
+	s.logger.Warn("rejected audit event",
+		"index", index, "auditID", event.AuditID, "verb", event.Verb, "error", err)
+}
+
+// buildCreate converts an encoded event into an AuditEvent builder
+func buildCreate(client *ent.Client, entry *Entry) *ent.AuditEventCreate {
+	event := &entry.Event
+	raw := entry.raw
+
+	item := client.AuditEvent.Create().
+		SetStage(string(event.Stage)).
+		SetAuditID(string(event.AuditID)).
+		SetVerb(event.Verb).
+		SetUserAgent(event.UserAgent).
+		SetLevel(string(event.Level)).
+		SetRequestTimestamp(event.RequestReceivedTimestamp.Time).
+		SetStageTimestamp(event.StageTimestamp.Time).
+		SetRaw(raw).
+		SetRawKeyID(rawcodec.KeyID()).
+		SetCluster(entry.Cluster).
+		SetSampleWeight(max(entry.SampleWeight, 1))
+	setExtractedFields(item.Mutation(), event)
+	if rawcodec.Enabled() {
+		item.SetRawSize(len(raw))
+	}
+
+	if event.ObjectRef != nil {
+		item.SetNamespace(event.ObjectRef.Namespace).
+			SetName(ExtractResourceName(event)).
+			SetApiVersion(event.ObjectRef.APIVersion).
+			SetApiGroup(event.ObjectRef.APIGroup).
+			SetResource(event.ObjectRef.Resource).
+			SetSubResource(event.ObjectRef.Subresource)
+	}
+	return item
+}
+
+// ValidateEvent checks that the event carries every field required by the AuditEvent schema
+func ValidateEvent(event *auditv1.Event) error {
+	if event.AuditID == "" {
+		return NewValidationError("auditID", "must not be empty")
+	}
+	if event.Level == "" {
+		return NewValidationError("level", "must not be empty")
+	}
+	if event.Stage == "" {
+		return NewValidationError("stage", "must not be empty")
+	}
+	if event.Verb == "" {
+		return NewValidationError("verb", "must not be empty")
+	}
+	if event.UserAgent == "" {
+		return NewValidationError("userAgent", "must not be empty")
+	}
+	if event.RequestReceivedTimestamp.IsZero() {
+		return NewValidationError("requestReceivedTimestamp", "must be set")
+	}
+	if event.StageTimestamp.IsZero() {
+		return NewValidationError("stageTimestamp", "must be set")
+	}
+	return nil
+}
+
+// ExtractResourceName returns the name of the resource targeted by the event
+func ExtractResourceName(event *auditv1.Event) string {
+	// First try objectRef.Name
+	if event.ObjectRef != nil && event.ObjectRef.Name != "" {
+		return event.ObjectRef.Name
+	}
+
+	// For resources created with generateName, objectRef.Name is empty
+	// Try to extract from responseObject.metadata.name
+	if event.ResponseObject != nil {
+		if name := metadataName(event.ResponseObject.Raw); name != "" {
+			return name
+		}
+	}
+
+	// Fallback to requestObject.metadata.name
+	if event.RequestObject != nil {
+		if name := metadataName(event.RequestObject.Raw); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// metadataName reads metadata.name from a serialized Kubernetes object
+func metadataName(raw []byte) string {
+	if raw == nil {
+		return ""
+	}
+	var obj struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return ""
+	}
+	return obj.Metadata.Name
+}
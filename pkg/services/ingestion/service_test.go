@@ -0,0 +1,183 @@
+package ingestion_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	dbName := fmt.Sprintf("file:ingestion_%d_%d?mode=memory&cache=shared&_fk=1",
+		time.Now().UnixNano(), rand.Int63())
+	client := enttest.Open(t, "sqlite3", dbName)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func newTestEvent(auditID, verb string) auditv1.Event {
+	now := metav1.NewMicroTime(time.Now())
+	return auditv1.Event{
+		Level:                    auditv1.LevelRequestResponse,
+		AuditID:                  types.UID(auditID),
+		Stage:                    auditv1.StageResponseComplete,
+		Verb:                     verb,
+		UserAgent:                "kubectl/v1.30.0",
+		RequestReceivedTimestamp: now,
+		StageTimestamp:           now,
+		ObjectRef: &auditv1.ObjectReference{
+			Resource:   "configmaps",
+			Namespace:  "default",
+			Name:       "my-config",
+			APIVersion: "v1",
+		},
+	}
+}
+
+// corruptPatches replaces the request object of patch events with invalid JSON
+type corruptPatches struct{}
+
+func (corruptPatches) Process(entry *ingestion.Entry) bool {
+	if entry.Event.Verb == "patch" {
+		entry.Event.RequestObject = &runtime.Unknown{Raw: []byte(`{"spec":`)}
+	}
+	return true
+}
+
+// forwardRecorder records the audit IDs of the forwarded events
+type forwardRecorder struct{ auditIDs []string }
+
+func (r *forwardRecorder) Forward(entries []ingestion.Entry) {
+	for _, entry := range entries {
+		r.auditIDs = append(r.auditIDs, string(entry.Event.AuditID))
+	}
+}
+
+func TestValidateEvent(t *testing.T) {
+	t.Run("should accept a complete event", func(t *testing.T) {
+		event := newTestEvent("a", "create")
+		assert.NoError(t, ingestion.ValidateEvent(&event))
+	})
+
+	t.Run("should reject events missing required fields", func(t *testing.T) {
+		cases := map[string]func(e *auditv1.Event){
+			"auditID":                  func(e *auditv1.Event) { e.AuditID = "" },
+			"level":                    func(e *auditv1.Event) { e.Level = "" },
+			"stage":                    func(e *auditv1.Event) { e.Stage = "" },
+			"verb":                     func(e *auditv1.Event) { e.Verb = "" },
+			"userAgent":                func(e *auditv1.Event) { e.UserAgent = "" },
+			"requestReceivedTimestamp": func(e *auditv1.Event) { e.RequestReceivedTimestamp = metav1.MicroTime{} },
+		}
+		for field, mutate := range cases {
+			event := newTestEvent("a", "create")
+			mutate(&event)
+			err := ingestion.ValidateEvent(&event)
+			require.Error(t, err, field)
+			assert.True(t, ingestion.IsValidationError(err))
+			assert.Contains(t, err.Error(), field)
+		}
+	})
+}
+
+func TestExtractResourceName(t *testing.T) {
+	t.Run("should prefer objectRef name", func(t *testing.T) {
+		event := newTestEvent("a", "create")
+		assert.Equal(t, "my-config", ingestion.ExtractResourceName(&event))
+	})
+
+	t.Run("should fall back to responseObject metadata for generateName", func(t *testing.T) {
+		event := newTestEvent("a", "create")
+		event.ObjectRef.Name = ""
+		event.ResponseObject = &runtime.Unknown{Raw: []byte(`{"metadata":{"name":"generated-x7k2p"}}`)}
+		assert.Equal(t, "generated-x7k2p", ingestion.ExtractResourceName(&event))
+	})
+
+	t.Run("should fall back to requestObject metadata", func(t *testing.T) {
+		event := newTestEvent("a", "create")
+		event.ObjectRef.Name = ""
+		event.RequestObject = &runtime.Unknown{Raw: []byte(`{"metadata":{"name":"from-request"}}`)}
+		assert.Equal(t, "from-request", ingestion.ExtractResourceName(&event))
+	})
+}
+
+func TestServiceIngest(t *testing.T) {
+	t.Run("should store valid events and report invalid ones", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+
+		invalid := newTestEvent("bad", "update")
+		invalid.Verb = ""
+		events := []auditv1.Event{newTestEvent("good-1", "create"), invalid, newTestEvent("good-2", "delete")}
+
+		result, err := service.Ingest(ctx, "", events)
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Received)
+		assert.Equal(t, 2, result.Accepted)
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, 1, result.Rejected[0].Index)
+		assert.Equal(t, "bad", result.Rejected[0].AuditID)
+
+		stored, err := client.AuditEvent.Query().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		assert.Equal(t, "my-config", stored[0].Name)
+		assert.Contains(t, stored[0].Raw, `"auditID":"good-1"`)
+	})
+
+	t.Run("should reject events that cannot be encoded", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		service := ingestion.NewService(client, ingestion.WithProcessors(corruptPatches{}))
+
+		result, err := service.Ingest(ctx, "", []auditv1.Event{newTestEvent("a", "create"), newTestEvent("b", "patch")})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Accepted)
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, 1, result.Rejected[0].Index)
+		assert.Equal(t, "b", result.Rejected[0].AuditID)
+		assert.Contains(t, result.Rejected[0].Reason, "raw")
+		assert.Equal(t, 1, client.AuditEvent.Query().CountX(ctx))
+	})
+
+	t.Run("should store and forward only the hand-built entries that can be encoded", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		forwarder := &forwardRecorder{}
+		service := ingestion.NewService(client, ingestion.WithForwarder(forwarder))
+
+		broken := newTestEvent("b", "patch")
+		broken.RequestObject = &runtime.Unknown{Raw: []byte(`{"spec":`)}
+		stored, err := service.Store(ctx, []ingestion.Entry{{Event: newTestEvent("a", "create")}, {Event: broken}})
+		assert.Equal(t, 1, stored)
+		var rejected *ingestion.RejectedError
+		require.ErrorAs(t, err, &rejected)
+		require.Len(t, rejected.Rejected, 1)
+		assert.Equal(t, 1, rejected.Rejected[0].Index)
+		assert.Equal(t, "b", rejected.Rejected[0].AuditID)
+		assert.Equal(t, []string{"a"}, forwarder.auditIDs)
+		assert.Equal(t, 1, client.AuditEvent.Query().CountX(ctx))
+	})
+
+	t.Run("should return a storage error when the database is unavailable", func(t *testing.T) {
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+		require.NoError(t, client.Close())
+
+		_, err := service.Ingest(context.Background(), "", []auditv1.Event{newTestEvent("a", "create")})
+		require.Error(t, err)
+		assert.True(t, ingestion.IsStorageError(err))
+	})
+}
@@ -0,0 +1,246 @@
+package ingestion
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// EventReader decodes the audit events of a webhook body one by one. The body is
+// an EventList, a single Event, or several of them separated by whitespace, such
+// as the newline-delimited Events forwarded by log shippers like Fluent Bit or
+// Vector.
+//
+// The items of a list are decoded one at a time when its kind and apiVersion
+// come before them, as the apiserver writes it, so that large lists are never
+// held in memory as JSON. Items of lists written in another order are buffered
+// until the end of the list.
+type EventReader struct {
+	decoder *json.Decoder
+	values  int
+
+	// Fields of the object being read, except its items
+	fields    map[string]json.RawMessage
+	inItems   bool
+	itemsSeen bool
+	buffered  []json.RawMessage
+	pending   []auditv1.Event
+}
+
+// NewEventReader creates a reader decoding the events of the body
+func NewEventReader(r io.Reader) *EventReader {
+	return &EventReader{decoder: json.NewDecoder(r)}
+}
+
+// Next returns the next event of the body, or io.EOF once every event was read.
+// Other errors are DecodeErrors, after which the reader must not be used.
+func (r *EventReader) Next() (*auditv1.Event, error) {
+	event, err := r.next()
+	if err != nil && err != io.EOF {
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			err = NewDecodeError(err)
+		}
+	}
+	return event, err
+}
+
+func (r *EventReader) next() (*auditv1.Event, error) {
+	for {
+		if len(r.pending) > 0 {
+			event := r.pending[0]
+			r.pending = r.pending[1:]
+			return &event, nil
+		}
+
+		switch {
+		case r.inItems:
+			if !r.decoder.More() {
+				// Consume the closing bracket of the items
+				if _, err := r.token(); err != nil {
+					return nil, err
+				}
+				r.inItems = false
+				continue
+			}
+			var item json.RawMessage
+			if err := r.decoder.Decode(&item); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			gv, ok, err := listVersion(r.fields)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				r.buffered = append(r.buffered, item)
+				continue
+			}
+			return decodeListItem(gv, item)
+
+		case r.fields != nil:
+			token, err := r.token()
+			if err != nil {
+				return nil, err
+			}
+			if token == json.Delim('}') {
+				if err := r.endObject(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			key, _ := token.(string)
+			if key == "items" {
+				if err := r.startItems(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			var value json.RawMessage
+			if err := r.decoder.Decode(&value); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			r.fields[key] = value
+
+		default:
+			token, err := r.decoder.Token()
+			if err == io.EOF {
+				if r.values == 0 {
+					return nil, NewDecodeError(ErrEmptyBody)
+				}
+				return nil, io.EOF
+			}
+			if err != nil {
+				return nil, err
+			}
+			if token != json.Delim('{') {
+				return nil, fmt.Errorf("expected an audit Event or EventList object, found %v", token)
+			}
+			r.values++
+			r.fields = map[string]json.RawMessage{}
+			r.itemsSeen = false
+			r.buffered = nil
+		}
+	}
+}
+
+// token reads the next token inside an object, where the end of the body is unexpected
+func (r *EventReader) token() (json.Token, error) {
+	token, err := r.decoder.Token()
+	return token, unexpectedEOF(err)
+}
+
+func (r *EventReader) startItems() error {
+	token, err := r.token()
+	if err != nil {
+		return err
+	}
+	r.itemsSeen = true
+	switch token {
+	case nil:
+		// "items": null is an empty list
+	case json.Delim('['):
+		r.inItems = true
+	default:
+		return fmt.Errorf("expected the items of the EventList, found %v", token)
+	}
+	return nil
+}
+
+// endObject decodes the buffered items of a list, or the object itself when it is an event
+func (r *EventReader) endObject() error {
+	fields := r.fields
+	r.fields = nil
+	if r.itemsSeen || stringField(fields, "kind") == "EventList" {
+		gv, ok, err := listVersion(fields)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("the EventList has no kind or apiVersion")
+		}
+		for _, item := range r.buffered {
+			event, err := decodeListItem(gv, item)
+			if err != nil {
+				return err
+			}
+			r.pending = append(r.pending, *event)
+		}
+		r.buffered = nil
+		return nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	event, err := DecodeEvent(data)
+	if err != nil {
+		return err
+	}
+	r.pending = append(r.pending, *event)
+	return nil
+}
+
+// listVersion returns the version of the list whose fields were read, false
+// while its kind or apiVersion has not been read yet
+func listVersion(fields map[string]json.RawMessage) (schema.GroupVersion, bool, error) {
+	kind, apiVersion := stringField(fields, "kind"), stringField(fields, "apiVersion")
+	if kind == "" || apiVersion == "" {
+		return schema.GroupVersion{}, false, nil
+	}
+	if kind != "EventList" {
+		return schema.GroupVersion{}, false, fmt.Errorf("expected an EventList, found kind %q", kind)
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersion{}, false, err
+	}
+	return gv, true, nil
+}
+
+// decodeListItem decodes an item of an EventList of the given version, items carrying no kind of their own
+func decodeListItem(gv schema.GroupVersion, item json.RawMessage) (*auditv1.Event, error) {
+	gvk := gv.WithKind("Event")
+	return decodeEvent(item, &gvk)
+}
+
+// ReadEvents decodes every event of a webhook body, see EventReader
+func ReadEvents(r io.Reader) ([]auditv1.Event, error) {
+	reader := NewEventReader(r)
+	var events []auditv1.Event
+	for {
+		event, err := reader.Next()
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+}
+
+// DecodeEvents decodes every event of a webhook body held in memory
+func DecodeEvents(data []byte) ([]auditv1.Event, error) {
+	return ReadEvents(bytes.NewReader(data))
+}
+
+func stringField(fields map[string]json.RawMessage, name string) string {
+	var value string
+	if raw, ok := fields[name]; ok {
+		_ = json.Unmarshal(raw, &value)
+	}
+	return value
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,237 @@
+package ingestion_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// eventBody encodes a single Event with its type, as written by the apiserver log backend
+func eventBody(t *testing.T, event auditv1.Event) string {
+	event.APIVersion = "audit.k8s.io/v1"
+	event.Kind = "Event"
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func auditIDs(events []auditv1.Event) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, string(event.AuditID))
+	}
+	return ids
+}
+
+func TestEventReader(t *testing.T) {
+	t.Run("should read EventLists, single Events and newline-delimited streams", func(t *testing.T) {
+		list := eventListBody(t, newTestEvent("a", "create"), newTestEvent("b", "get"))
+		single := eventBody(t, newTestEvent("c", "delete"))
+
+		for name, tc := range map[string]struct {
+			body string
+			ids  []string
+		}{
+			"list":   {body: list, ids: []string{"a", "b"}},
+			"event":  {body: single, ids: []string{"c"}},
+			"ndjson": {body: single + "\n" + eventBody(t, newTestEvent("d", "get")) + "\n", ids: []string{"c", "d"}},
+			"mixed":  {body: list + "\n" + single, ids: []string{"a", "b", "c"}},
+			"empty list": {
+				body: `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":null}`,
+			},
+			"items before the type": {
+				body: `{"items":[` + single + `],"apiVersion":"audit.k8s.io/v1","kind":"EventList"}`,
+				ids:  []string{"c"},
+			},
+		} {
+			events, err := ingestion.DecodeEvents([]byte(tc.body))
+			require.NoError(t, err, name)
+			if tc.ids == nil {
+				assert.Empty(t, events, name)
+			} else {
+				assert.Equal(t, tc.ids, auditIDs(events), name)
+			}
+		}
+	})
+
+	t.Run("should decode list items before the end of the body", func(t *testing.T) {
+		reader, writer := io.Pipe()
+		defer reader.Close()
+		go func() {
+			writer.Write([]byte(`{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[` + eventBody(t, newTestEvent("a", "get")) + `,`))
+		}()
+
+		event, err := ingestion.NewEventReader(reader).Next()
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(event.AuditID))
+		writer.Close()
+	})
+
+	t.Run("should reject bodies that are not audit events", func(t *testing.T) {
+		for name, body := range map[string]string{
+			"truncated":       `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[{"auditID":"a"}`,
+			"array":           `[]`,
+			"untyped list":    `{"items":[]}`,
+			"other kind":      `{"kind":"PodList","apiVersion":"audit.k8s.io/v1","items":[{}]}`,
+			"unknown version": `{"kind":"EventList","apiVersion":"audit.k8s.io/v2","items":[{}]}`,
+			"trailing data":   eventBody(t, newTestEvent("a", "get")) + " garbage",
+		} {
+			_, err := ingestion.DecodeEvents([]byte(body))
+			var decodeErr *ingestion.DecodeError
+			assert.ErrorAs(t, err, &decodeErr, name)
+		}
+
+		_, err := ingestion.DecodeEvents([]byte(" \n"))
+		assert.ErrorIs(t, err, ingestion.ErrEmptyBody)
+	})
+}
+
+func TestWebhookHandlerPayloads(t *testing.T) {
+	post := func(router http.Handler, body io.Reader, encoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/audit-webhook", body)
+		if encoding != "" {
+			req.Header.Set("Content-Encoding", encoding)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("should accept gzip encoded newline-delimited events", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		compressed := &bytes.Buffer{}
+		writer := gzip.NewWriter(compressed)
+		writer.Write([]byte(eventBody(t, newTestEvent("a", "get")) + "\n" + eventBody(t, newTestEvent("b", "get")) + "\n"))
+		require.NoError(t, writer.Close())
+
+		recorder := post(router, compressed, "gzip")
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		assert.Equal(t, 2, client.AuditEvent.Query().CountX(t.Context()))
+	})
+
+	t.Run("should reject invalid gzip bodies and unknown encodings", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters()))
+
+		assert.Equal(t, http.StatusBadRequest, post(router, strings.NewReader("not gzip"), "gzip").Code)
+		assert.Equal(t, http.StatusUnsupportedMediaType, post(router, strings.NewReader("{}"), "br").Code)
+	})
+
+	t.Run("should keep the whole body of undecodable requests", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters()))
+
+		body := `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[{"level":5}` + strings.Repeat(" ", 1<<16) + `]}`
+		assert.Equal(t, http.StatusBadRequest, post(router, strings.NewReader(body), "").Code)
+		assert.Equal(t, body, client.DeadLetter.Query().OnlyX(t.Context()).Payload)
+	})
+
+	t.Run("should refuse bodies larger than the limit before and after decompression", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client, ingestion.WithDeadLetters(), ingestion.WithMaxBodySize(4096)))
+
+		event := eventBody(t, newTestEvent("a", "get"))
+		require.Equal(t, http.StatusOK, post(router, strings.NewReader(event), "").Code)
+
+		large := event + strings.Repeat(" ", 4096)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, post(router, strings.NewReader(large), "").Code)
+
+		compressed := &bytes.Buffer{}
+		writer := gzip.NewWriter(compressed)
+		writer.Write([]byte(large))
+		require.NoError(t, writer.Close())
+		require.Less(t, compressed.Len(), 4096)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, post(router, compressed, "gzip").Code)
+		assert.Zero(t, client.DeadLetter.Query().CountX(t.Context()))
+	})
+
+	t.Run("should hand the events over to the sink in bounded chunks", func(t *testing.T) {
+		client := setupTestDB(t)
+		service := ingestion.NewService(client)
+		sink := &chunkRecorder{service: service}
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.POST("/api/audit-webhook", ingestion.NewWebhookHandler(service, sink))
+
+		var body strings.Builder
+		for i := 0; i < 1201; i++ {
+			body.WriteString(eventBody(t, newTestEvent(fmt.Sprint("e", i), "get")) + "\n")
+		}
+		recorder := post(router, strings.NewReader(body.String()), "")
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		assert.Equal(t, []int{500, 500, 201}, sink.sizes)
+		assert.Equal(t, 1201, client.AuditEvent.Query().CountX(t.Context()))
+	})
+}
+
+// chunkRecorder stores the entries with the service, recording the size of every submission
+type chunkRecorder struct {
+	service *ingestion.Service
+	sizes   []int
+}
+
+func (r *chunkRecorder) Submit(ctx context.Context, entries []ingestion.Entry) error {
+	r.sizes = append(r.sizes, len(entries))
+	return r.service.Submit(ctx, entries)
+}
+
+func TestV1beta1Events(t *testing.T) {
+	// An event written by an apiserver predating the request and stage timestamps
+	legacy := `{"kind":"Event","apiVersion":"audit.k8s.io/v1beta1","metadata":{"creationTimestamp":"2018-03-01T10:00:00Z"},` +
+		`"level":"Metadata","timestamp":"2018-03-01T10:00:00Z","auditID":"old","stage":"ResponseComplete","requestURI":"/api/v1/namespaces/default/pods/web",` +
+		`"verb":"delete","user":{"username":"admin"},"sourceIPs":["10.0.0.1"],"userAgent":"kubectl/v1.9.0",` +
+		`"objectRef":{"resource":"pods","namespace":"default","name":"web","apiVersion":"v1"},"responseStatus":{"metadata":{},"code":200}}`
+	list := `{"kind":"EventList","apiVersion":"audit.k8s.io/v1beta1","metadata":{},"items":[` +
+		`{"level":"Metadata","auditID":"new","stage":"ResponseComplete","requestURI":"/api/v1/namespaces/default/pods","verb":"create",` +
+		`"user":{"username":"admin"},"userAgent":"kubectl/v1.20.0","objectRef":{"resource":"pods","namespace":"default","apiVersion":"v1"},` +
+		`"requestReceivedTimestamp":"2021-06-01T10:00:00.123456Z","stageTimestamp":"2021-06-01T10:00:00.234567Z"}]}`
+
+	t.Run("should convert v1beta1 events into v1", func(t *testing.T) {
+		events, err := ingestion.DecodeEvents([]byte(legacy + "\n" + list))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		old := events[0]
+		assert.Equal(t, "delete", old.Verb)
+		assert.Equal(t, "web", old.ObjectRef.Name)
+		assert.Equal(t, int32(200), old.ResponseStatus.Code)
+		assert.Equal(t, "2018-03-01T10:00:00Z", old.RequestReceivedTimestamp.UTC().Format(time.RFC3339))
+		assert.Equal(t, old.RequestReceivedTimestamp, old.StageTimestamp)
+
+		assert.Equal(t, "new", string(events[1].AuditID))
+		assert.Equal(t, 234567000, events[1].StageTimestamp.Nanosecond())
+
+		single, err := ingestion.DecodeEvent([]byte(legacy))
+		require.NoError(t, err)
+		assert.Equal(t, old, *single)
+	})
+
+	t.Run("should store v1beta1 events as v1", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		recorder, response := postWebhook(t, router, list)
+		require.Equal(t, http.StatusOK, recorder.Code, response.Error)
+		stored := client.AuditEvent.Query().OnlyX(ctx)
+		assert.Contains(t, stored.Raw, `"apiVersion":"audit.k8s.io/v1"`)
+		assert.Equal(t, "pods", stored.Resource)
+		assert.Equal(t, "admin", stored.Username)
+	})
+}
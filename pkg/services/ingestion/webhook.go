@@ -0,0 +1,259 @@
+package ingestion
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// DefaultRetryAfter is the delay suggested to the apiserver when a batch could not be stored
+const DefaultRetryAfter = 5 * time.Second
+
+// DefaultMaxBodySize is the largest webhook body accepted, before and after decompression
+const DefaultMaxBodySize = 64 << 20
+
+// WebhookChunkSize is the number of events decoded from a body before they are
+// handed over to the sink. A queue must be able to hold one chunk.
+const WebhookChunkSize = 500
+
+// WebhookResponse is the JSON body returned by the audit webhook endpoint
+type WebhookResponse struct {
+	Error  string  `json:"error,omitempty"`
+	Result *Result `json:"result,omitempty"`
+}
+
+// NewWebhookHandler returns a gin handler receiving EventLists from the apiserver audit webhook backend,
+// as well as single Events and newline-delimited Events, optionally gzip encoded (see EventReader).
+// Events are validated by the service and handed over to the sink, which is either the
+// service itself (synchronous writes) or a Queue. When mounted on a path with a :cluster
+// parameter, events are attributed to that cluster. With WithDeadLetters, bodies
+// that cannot be decoded, unless WithoutDeadLetterBodies, and rejected events
+// are kept to be reprocessed.
+//
+// Bodies larger than the maximum body size, compressed or not, are refused with
+// 413. Events are handed over to the sink in chunks as they are decoded, so that
+// a large body is never held in memory: when a later chunk fails, the earlier
+// ones are already submitted, and are skipped as duplicates when the apiserver
+// retries the batch.
+//
+// Status codes follow what the webhook backend expects: 4xx for payloads that
+// will never succeed, and 429/503 with Retry-After for transient conditions so
+// that the batch is retried.
+func NewWebhookHandler(service *Service, sink Sink) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cluster, status, err := requestCluster(c)
+		if err != nil {
+			c.JSON(status, WebhookResponse{Error: err.Error()})
+			return
+		}
+
+		body, err := requestBody(c, service.maxBodySize)
+		if err != nil {
+			c.JSON(http.StatusUnsupportedMediaType, WebhookResponse{Error: err.Error()})
+			return
+		}
+		defer body.Close()
+
+		// Bodies are decoded as they are read; a bounded copy is only kept for the dead letters
+		var kept *limitedBuffer
+		var reader io.Reader = body
+		if service.deadLetters && !service.skipDeadLetterBodies {
+			kept = &limitedBuffer{limit: MaxDeadLetterBodySize}
+			reader = io.TeeReader(body, kept)
+		}
+		events := NewEventReader(reader)
+		result := &Result{}
+		for {
+			chunk, readErr := readChunk(events, WebhookChunkSize)
+			if len(chunk) > 0 {
+				accepted, chunkResult := service.Prepare(cluster, chunk)
+				service.recordRejected(c.Request.Context(), cluster, c.ClientIP(), chunk, chunkResult)
+				result.merge(chunkResult)
+				if len(accepted) > 0 {
+					if err := sink.Submit(c.Request.Context(), accepted); err != nil {
+						respondSubmitError(c, sink, err, result)
+						return
+					}
+				}
+			}
+			if readErr == io.EOF {
+				break
+			}
+			if errors.Is(readErr, ErrBodyTooLarge) {
+				service.logger.Warn("webhook body too large", "remoteAddr", c.ClientIP(), "limit", service.maxBodySize)
+				c.JSON(http.StatusRequestEntityTooLarge, WebhookResponse{Error: readErr.Error(), Result: result})
+				return
+			}
+			if readErr != nil {
+				service.metrics.recordDecodeFailure()
+				service.logger.Warn("failed to decode webhook body", "remoteAddr", c.ClientIP(), "error", readErr)
+				if kept != nil {
+					// Keep the rest of the body, the decoder stopped at the error
+					_, _ = io.Copy(io.Discard, reader)
+					if kept.truncated {
+						service.logger.Warn("webhook body too large to be kept as a dead letter", "limit", MaxDeadLetterBodySize)
+					} else {
+						service.recordDeadLetter(c.Request.Context(), DeadLetterBody, cluster, c.ClientIP(), kept.Bytes(), readErr.Error())
+					}
+				}
+				c.JSON(http.StatusBadRequest, WebhookResponse{Error: readErr.Error(), Result: result})
+				return
+			}
+		}
+
+		// Nothing in the batch was usable; retrying it would not help
+		if result.Accepted == 0 && len(result.Rejected) > 0 {
+			c.JSON(http.StatusBadRequest, WebhookResponse{Error: "all events were rejected", Result: result})
+			return
+		}
+		c.JSON(http.StatusOK, WebhookResponse{Result: result})
+	}
+}
+
+// readChunk decodes up to size events, returning them with io.EOF at the end of
+// the body, or with the error that stopped the decoding
+func readChunk(reader *EventReader, size int) ([]auditv1.Event, error) {
+	events := make([]auditv1.Event, 0, size)
+	for len(events) < size {
+		event, err := reader.Next()
+		if err != nil {
+			return events, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+// respondSubmitError answers a batch the sink did not accept
+func respondSubmitError(c *gin.Context, sink Sink, err error, result *Result) {
+	switch {
+	case errors.Is(err, ErrQueueFull):
+		setRetryAfter(c, retryAfter(sink))
+		c.JSON(http.StatusTooManyRequests, WebhookResponse{Error: err.Error(), Result: result})
+	case errors.Is(err, ErrQueueClosed), IsStorageError(err):
+		setRetryAfter(c, DefaultRetryAfter)
+		c.JSON(http.StatusServiceUnavailable, WebhookResponse{Error: err.Error(), Result: result})
+	default:
+		c.JSON(http.StatusInternalServerError, WebhookResponse{Error: err.Error(), Result: result})
+	}
+}
+
+// requestBody returns the body of the request, decompressed according to its
+// Content-Encoding, failing with ErrBodyTooLarge past maxSize bytes before and
+// after decompression
+func requestBody(c *gin.Context, maxSize int64) (io.ReadCloser, error) {
+	body := &limitedReader{body: c.Request.Body, remaining: maxSize}
+	switch encoding := strings.ToLower(strings.TrimSpace(c.GetHeader("Content-Encoding"))); encoding {
+	case "", "identity":
+		return body, nil
+	case "gzip", "x-gzip":
+		return &limitedReader{body: newGzipBody(body), remaining: maxSize}, nil
+	default:
+		return nil, fmt.Errorf("unsupported Content-Encoding %q, only gzip is supported", encoding)
+	}
+}
+
+// NewStatsHandler returns a gin handler exposing the queue statistics as JSON
+func NewStatsHandler(queue *Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, queue.Stats())
+	}
+}
+
+// retryAfter asks the sink for a retry delay, falling back to DefaultRetryAfter
+func retryAfter(sink Sink) time.Duration {
+	if s, ok := sink.(interface{ RetryAfter() time.Duration }); ok {
+		return s.RetryAfter()
+	}
+	return DefaultRetryAfter
+}
+
+func setRetryAfter(c *gin.Context, d time.Duration) {
+	seconds := int(d.Round(time.Second).Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(seconds))
+}
+
+// MaxDeadLetterBodySize is the size above which undecodable webhook bodies are not kept as dead letters
+const MaxDeadLetterBodySize = 8 << 20
+
+// limitedBuffer keeps the first limit bytes written to it
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write never fails, so that the copy does not interrupt the decoding of the body
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := b.limit - b.Len(); n > room {
+		b.truncated = true
+		p = p[:max(room, 0)]
+	}
+	b.Buffer.Write(p)
+	return n, nil
+}
+
+// limitedReader fails with ErrBodyTooLarge once more than remaining bytes are read
+type limitedReader struct {
+	body      io.ReadCloser
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if r.remaining < 0 {
+		return 0, ErrBodyTooLarge
+	}
+	// Read one byte past the limit to tell a body of exactly the limit from a larger one
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.body.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, ErrBodyTooLarge
+	}
+	return n, err
+}
+
+func (r *limitedReader) Close() error {
+	return r.body.Close()
+}
+
+// gzipBody decompresses a request body, reading its gzip header on the first Read
+// so that an invalid header is reported as a decode failure
+type gzipBody struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+func newGzipBody(body io.ReadCloser) *gzipBody {
+	return &gzipBody{body: body}
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.reader == nil && b.err == nil {
+		b.reader, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.reader.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,93 @@
+package ingestion_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func newTestRouter(service *ingestion.Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/api/audit-webhook", ingestion.NewWebhookHandler(service, service))
+	return router
+}
+
+func postWebhook(t *testing.T, router http.Handler, body string) (*httptest.ResponseRecorder, ingestion.WebhookResponse) {
+	req := httptest.NewRequest(http.MethodPost, "/api/audit-webhook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	var response ingestion.WebhookResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	return recorder, response
+}
+
+func eventListBody(t *testing.T, events ...auditv1.Event) string {
+	list := auditv1.EventList{Items: events}
+	list.APIVersion = "audit.k8s.io/v1"
+	list.Kind = "EventList"
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestWebhookHandler(t *testing.T) {
+	t.Run("should return 200 with the ingestion result", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		recorder, response := postWebhook(t, router, eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		require.NotNil(t, response.Result)
+		assert.Equal(t, 1, response.Result.Accepted)
+	})
+
+	t.Run("should return 400 for undecodable payloads", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		recorder, response := postWebhook(t, router, `{"items": [`)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.NotEmpty(t, response.Error)
+	})
+
+	t.Run("should return 400 for empty payloads", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		recorder, _ := postWebhook(t, router, "")
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("should return 400 when every event is rejected", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+
+		invalid := newTestEvent("a", "create")
+		invalid.Level = ""
+		recorder, response := postWebhook(t, router, eventListBody(t, invalid))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		require.NotNil(t, response.Result)
+		assert.Len(t, response.Result.Rejected, 1)
+	})
+
+	t.Run("should return 503 with Retry-After when storage fails", func(t *testing.T) {
+		client := setupTestDB(t)
+		router := newTestRouter(ingestion.NewService(client))
+		require.NoError(t, client.Close())
+
+		recorder, _ := postWebhook(t, router, eventListBody(t, newTestEvent("a", "create")))
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.NotEmpty(t, recorder.Header().Get("Retry-After"))
+	})
+}
@@ -0,0 +1,98 @@
+package logfile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileState is the progress recorded for one audit log file
+type FileState struct {
+	// Path is where the file was last seen; rotation may have renamed it since
+	Path string `json:"path"`
+	// Offset is the number of (decompressed) bytes already ingested
+	Offset int64 `json:"offset"`
+	// Complete marks compressed files that have been read to the end
+	Complete  bool      `json:"complete,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Checkpoints persists file offsets across restarts.
+// Files are keyed by a fingerprint of their first line, so a file keeps its
+// progress when it is renamed or compressed by log rotation.
+type Checkpoints struct {
+	path string
+
+	mu    sync.Mutex
+	Files map[string]*FileState `json:"files"`
+}
+
+// LoadCheckpoints reads the checkpoint file, starting empty when it does not exist yet
+func LoadCheckpoints(path string) (*Checkpoints, error) {
+	checkpoints := &Checkpoints{path: path, Files: map[string]*FileState{}}
+	if path == "" {
+		return checkpoints, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, checkpoints); err != nil {
+		return nil, err
+	}
+	if checkpoints.Files == nil {
+		checkpoints.Files = map[string]*FileState{}
+	}
+	return checkpoints, nil
+}
+
+// Get returns a copy of the state recorded for the fingerprint
+func (c *Checkpoints) Get(fingerprint string) FileState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if state, ok := c.Files[fingerprint]; ok {
+		return *state
+	}
+	return FileState{}
+}
+
+// Set records the state for the fingerprint and persists all checkpoints
+func (c *Checkpoints) Set(fingerprint string, state FileState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state.UpdatedAt = time.Now()
+	c.Files[fingerprint] = &state
+	return c.save()
+}
+
+// save atomically replaces the checkpoint file
+func (c *Checkpoints) save() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
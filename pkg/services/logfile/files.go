@@ -0,0 +1,202 @@
+package logfile
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// fingerprintLimit bounds how much of the first line is hashed
+const fingerprintLimit = 64 * 1024
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// openFile opens an audit log file positioned at the given offset of its
+// content, transparently decompressing gzip and zstd files. Plain files are
+// seeked, compressed ones have to be decompressed up to the offset.
+func openFile(path string, offset int64) (io.ReadCloser, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	magic := make([]byte, len(zstdMagic))
+	n, err := io.ReadFull(f, magic)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		f.Close()
+		return nil, false, err
+	}
+	magic = magic[:n]
+
+	var rc io.ReadCloser
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		rc, err = newDecompressor(f, func(r io.Reader) (io.ReadCloser, error) { return gzip.NewReader(r) })
+	case bytes.HasPrefix(magic, zstdMagic):
+		rc, err = newDecompressor(f, func(r io.Reader) (io.ReadCloser, error) {
+			decoder, err := zstd.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			return decoder.IOReadCloser(), nil
+		})
+	default:
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, false, err
+		}
+		return f, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+
+	if _, err := io.CopyN(io.Discard, rc, offset); err != nil && !errors.Is(err, io.EOF) {
+		rc.Close()
+		return nil, true, err
+	}
+	return rc, true, nil
+}
+
+// newDecompressor rewinds the file and wraps it with a decompressing reader
+func newDecompressor(f *os.File, wrap func(io.Reader) (io.ReadCloser, error)) (io.ReadCloser, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	decompressor, err := wrap(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return readCloser{Reader: decompressor, Closer: closers{decompressor, f}}, nil
+}
+
+// fingerprint identifies a file by the hash of its first line. An empty
+// fingerprint means the first line has not been completely written yet;
+// for finished files a first line without newline is accepted as well.
+func fingerprint(path string, archived bool) (string, error) {
+	rc, compressed, err := openFile(path, 0)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	reader := bufio.NewReaderSize(rc, fingerprintLimit)
+	line, err := reader.ReadSlice('\n')
+	switch {
+	case err == nil, errors.Is(err, bufio.ErrBufferFull):
+	case errors.Is(err, io.EOF):
+		if len(line) == 0 || !(compressed || archived) {
+			return "", nil
+		}
+	default:
+		return "", err
+	}
+	sum := sha256.Sum256(line)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// discover expands the configured paths into the files to read, rotated files
+// first (oldest to newest) and the active file last. Rotated files are the
+// siblings named by the apiserver log backend ("audit-2024-01-01T00-00-00.000.log",
+// optionally compressed) or by logrotate ("audit.log.1", "audit.log.2.gz").
+func discover(patterns []string) ([]string, error) {
+	var files []string
+	seen := map[string]bool{}
+	add := func(path string) {
+		if seen[path] {
+			return
+		}
+		seen[path] = true
+		files = append(files, path)
+	}
+
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, active := range matches {
+			info, err := os.Stat(active)
+			if err != nil || info.IsDir() {
+				continue
+			}
+			rotated, err := rotatedSiblings(active)
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range rotated {
+				add(path)
+			}
+			add(active)
+		}
+	}
+	return files, nil
+}
+
+// rotatedSiblings lists rotated copies of the active file ordered by modification time
+func rotatedSiblings(active string) ([]string, error) {
+	ext := filepath.Ext(active)
+	stem := strings.TrimSuffix(active, ext)
+
+	var candidates []string
+	for _, pattern := range []string{stem + "-*" + ext + "*", active + ".*"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, matches...)
+	}
+
+	type rotatedFile struct {
+		path    string
+		modTime int64
+	}
+	var rotated []rotatedFile
+	for _, path := range candidates {
+		if path == active {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		rotated = append(rotated, rotatedFile{path: path, modTime: info.ModTime().UnixNano()})
+	}
+	sort.SliceStable(rotated, func(i, j int) bool { return rotated[i].modTime < rotated[j].modTime })
+
+	paths := make([]string, 0, len(rotated))
+	for _, r := range rotated {
+		paths = append(paths, r.path)
+	}
+	return paths, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type closers []io.Closer
+
+func (c closers) Close() error {
+	var errs []error
+	for _, closer := range c {
+		errs = append(errs, closer.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,236 @@
+package logfile
+
+import (
+	"context"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// ImportOptions configures a historical import
+type ImportOptions struct {
+	// Workers is the number of files parsed in parallel
+	Workers int
+	// BatchSize is the number of events written at once
+	BatchSize int
+	// ProgressInterval is how often progress is reported, 0 disables reporting
+	ProgressInterval time.Duration
+	// Cluster is the cluster the events are attributed to, empty for the service default
+	Cluster string
+}
+
+// DefaultImportOptions returns the options used when nothing is configured
+func DefaultImportOptions() ImportOptions {
+	return ImportOptions{
+		Workers:          4,
+		BatchSize:        2000,
+		ProgressInterval: 10 * time.Second,
+	}
+}
+
+// ImportReport summarizes a historical import
+type ImportReport struct {
+	Stats
+	// TotalFiles is the number of audit log files found in the directory
+	TotalFiles int `json:"totalFiles"`
+	// Failed lists files that could not be imported, with the reason
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// Importer bulk-loads archived audit log files found under a directory.
+// Finished files are recorded in the checkpoints, so an interrupted import
+// resumes where it stopped when it is run again.
+type Importer struct {
+	ingester    *Ingester
+	checkpoints *Checkpoints
+	opts        ImportOptions
+	logger      *slog.Logger
+}
+
+// NewImporter creates an importer validating with service and storing through sink
+func NewImporter(service *ingestion.Service, sink ingestion.Sink, checkpoints *Checkpoints, opts ImportOptions) *Importer {
+	defaults := DefaultImportOptions()
+	if opts.Workers <= 0 {
+		opts.Workers = defaults.Workers
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	return &Importer{
+		ingester:    NewIngester(service, sink, checkpoints, Options{BatchSize: opts.BatchSize, Archived: true, Cluster: opts.Cluster}),
+		checkpoints: checkpoints,
+		opts:        opts,
+		logger:      slog.Default().With("component", "import"),
+	}
+}
+
+// Import walks the directory and imports every audit log file in it
+func (im *Importer) Import(ctx context.Context, root string) (*ImportReport, error) {
+	paths, err := walkAuditLogs(root, im.checkpoints.path)
+	if err != nil {
+		return nil, err
+	}
+	report := &ImportReport{TotalFiles: len(paths), Failed: map[string]string{}}
+
+	var done atomic.Int64
+	stopProgress := im.reportProgress(len(paths), &done)
+	defer stopProgress()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan string)
+	for w := 0; w < im.opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range queue {
+				if err := im.ingester.ingestFile(ctx, path); err != nil && ctx.Err() == nil {
+					im.logger.Error("failed to import audit log file", "path", path, "error", err)
+					mu.Lock()
+					report.Failed[path] = err.Error()
+					mu.Unlock()
+				}
+				done.Add(1)
+			}
+		}()
+	}
+
+feed:
+	for _, path := range paths {
+		select {
+		case queue <- path:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	report.Stats = im.ingester.Stats()
+	return report, ctx.Err()
+}
+
+// reportProgress periodically logs the import progress until the returned function is called
+func (im *Importer) reportProgress(total int, done *atomic.Int64) func() {
+	if im.opts.ProgressInterval <= 0 {
+		return func() {}
+	}
+	start := time.Now()
+	ticker := time.NewTicker(im.opts.ProgressInterval)
+	stop := make(chan struct{})
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				stats := im.ingester.Stats()
+				elapsed := time.Since(start).Seconds()
+				im.logger.Info("import progress",
+					"files", done.Load(), "totalFiles", total,
+					"events", stats.Lines, "submitted", stats.Submitted,
+					"megabytes", stats.Bytes/(1024*1024),
+					"eventsPerSecond", int64(float64(stats.Lines)/elapsed))
+			}
+		}
+	}()
+	return func() { close(stop) }
+}
+
+// walkAuditLogs lists the regular files under root, skipping hidden files and
+// the checkpoint file with its temporary copies, in lexical order so that
+// rotated files are imported oldest first
+func walkAuditLogs(root, checkpointPath string) ([]string, error) {
+	checkpoint, err := filepath.Abs(checkpointPath)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if path != root && strings.HasPrefix(name, ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if abs, err := filepath.Abs(path); err == nil && (abs == checkpoint || strings.HasPrefix(abs, checkpoint+".tmp-")) {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	sort.Strings(paths)
+	return paths, err
+}
+
+// VerbResourceCount is the number of events for one verb and resource
+type VerbResourceCount struct {
+	Verb     string `json:"verb"`
+	Resource string `json:"resource"`
+	Count    int64  `json:"count"`
+}
+
+// CountingSink is a Sink that stores nothing and only counts events by verb and resource.
+// It backs the dry-run mode of the import.
+type CountingSink struct {
+	mu     sync.Mutex
+	counts map[[2]string]int64
+}
+
+// NewCountingSink creates an empty counting sink
+func NewCountingSink() *CountingSink {
+	return &CountingSink{counts: map[[2]string]int64{}}
+}
+
+// Submit counts the events
+func (s *CountingSink) Submit(_ context.Context, entries []ingestion.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range entries {
+		event := &entries[i].Event
+		resource := ""
+		if ref := event.ObjectRef; ref != nil {
+			resource = ref.Resource
+			if ref.APIGroup != "" {
+				resource += "." + ref.APIGroup
+			}
+		}
+		s.counts[[2]string{event.Verb, resource}]++
+	}
+	return nil
+}
+
+// Counts returns the counts ordered from the most to the least frequent
+func (s *CountingSink) Counts() []VerbResourceCount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := make([]VerbResourceCount, 0, len(s.counts))
+	for key, count := range s.counts {
+		counts = append(counts, VerbResourceCount{Verb: key[0], Resource: key[1], Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		if counts[i].Verb != counts[j].Verb {
+			return counts[i].Verb < counts[j].Verb
+		}
+		return counts[i].Resource < counts[j].Resource
+	})
+	return counts
+}
@@ -0,0 +1,103 @@
+package logfile_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/logfile"
+)
+
+func writeZstdFile(t *testing.T, path, content string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	encoder, err := zstd.NewWriter(f)
+	require.NoError(t, err)
+	_, err = encoder.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, encoder.Close())
+	require.NoError(t, f.Close())
+}
+
+func writeArchive(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "node-1"), 0o755))
+	writeFile(t, filepath.Join(dir, "node-1", "audit-2024-01-01.log"), eventLines(t, 0, 3))
+	writeGzipFile(t, filepath.Join(dir, "node-1", "audit-2024-01-02.log.gz"), eventLines(t, 3, 6))
+	// The last line of an archived file may lack its newline
+	writeZstdFile(t, filepath.Join(dir, "audit-2024-01-03.log.zst"), strings.TrimSuffix(eventLines(t, 6, 10), "\n"))
+	return dir
+}
+
+func TestImporter(t *testing.T) {
+	t.Run("should import plain, gzip and zstd files in parallel", func(t *testing.T) {
+		client := setupTestDB(t)
+		dir := writeArchive(t)
+		checkpoints, err := logfile.LoadCheckpoints(filepath.Join(t.TempDir(), "checkpoints.json"))
+		require.NoError(t, err)
+		service := ingestion.NewService(client)
+
+		report, err := logfile.NewImporter(service, service, checkpoints, logfile.ImportOptions{Workers: 3}).
+			Import(context.Background(), dir)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.TotalFiles)
+		assert.Equal(t, int64(10), report.Submitted)
+		assert.Empty(t, report.Failed)
+		assert.Equal(t, 10, countEvents(t, client))
+	})
+
+	t.Run("should import json logs and skip only the checkpoint file", func(t *testing.T) {
+		client := setupTestDB(t)
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "audit.json"), eventLines(t, 0, 2))
+		writeFile(t, filepath.Join(dir, "audit.json.1"), eventLines(t, 2, 3))
+		checkpointPath := filepath.Join(dir, "checkpoints.json")
+		for run := 0; run < 2; run++ {
+			checkpoints, err := logfile.LoadCheckpoints(checkpointPath)
+			require.NoError(t, err)
+			service := ingestion.NewService(client)
+
+			report, err := logfile.NewImporter(service, service, checkpoints, logfile.ImportOptions{}).
+				Import(context.Background(), dir)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.TotalFiles, "run %d", run)
+			assert.Empty(t, report.Failed, "run %d", run)
+		}
+		require.FileExists(t, checkpointPath)
+		assert.Equal(t, 3, countEvents(t, client))
+	})
+
+	t.Run("should skip files finished by a previous run", func(t *testing.T) {
+		client := setupTestDB(t)
+		dir := writeArchive(t)
+		checkpointPath := filepath.Join(t.TempDir(), "checkpoints.json")
+		service := ingestion.NewService(client)
+
+		for run, expected := range []int64{10, 0} {
+			checkpoints, err := logfile.LoadCheckpoints(checkpointPath)
+			require.NoError(t, err)
+			report, err := logfile.NewImporter(service, service, checkpoints, logfile.ImportOptions{}).
+				Import(context.Background(), dir)
+			require.NoError(t, err)
+			assert.Equal(t, expected, report.Submitted, "run %d", run)
+		}
+	})
+
+	t.Run("should only count events in dry-run mode", func(t *testing.T) {
+		dir := writeArchive(t)
+		checkpoints, err := logfile.LoadCheckpoints("")
+		require.NoError(t, err)
+		sink := logfile.NewCountingSink()
+
+		_, err = logfile.NewImporter(ingestion.NewService(nil), sink, checkpoints, logfile.ImportOptions{}).
+			Import(context.Background(), dir)
+		require.NoError(t, err)
+		assert.Equal(t, []logfile.VerbResourceCount{{Verb: "create", Resource: "", Count: 10}}, sink.Counts())
+	})
+}
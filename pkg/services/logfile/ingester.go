@@ -0,0 +1,236 @@
+package logfile
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// maxLineSize is the initial buffer size for a single audit event line
+const maxLineSize = 1024 * 1024
+
+// Options configures the log file ingester
+type Options struct {
+	// BatchSize is the number of events submitted at once
+	BatchSize int
+	// Follow keeps watching the files for new events after reaching their end
+	Follow bool
+	// PollInterval is how often followed files are checked for new content
+	PollInterval time.Duration
+	// Archived declares that files are no longer written to: trailing lines without
+	// newline are ingested and finished files are never read again
+	Archived bool
+	// Cluster is the cluster the events are attributed to, empty for the service default
+	Cluster string
+}
+
+// DefaultOptions returns the options used when nothing is configured
+func DefaultOptions() Options {
+	return Options{
+		BatchSize:    500,
+		PollInterval: time.Second,
+	}
+}
+
+// Stats counts what the ingester has processed
+type Stats struct {
+	Files        int64 `json:"files"`
+	Lines        int64 `json:"lines"`
+	Bytes        int64 `json:"bytes"`
+	Submitted    int64 `json:"submitted"`
+	Rejected     int64 `json:"rejected"`
+	Dropped      int64 `json:"dropped"` // valid events discarded by the ingestion processors
+	DecodeErrors int64 `json:"decodeErrors"`
+}
+
+// Ingester reads newline-delimited audit.k8s.io/v1 events written by the apiserver
+// log backend and feeds them through the same validation and sink as the webhook.
+type Ingester struct {
+	service     *ingestion.Service
+	sink        ingestion.Sink
+	checkpoints *Checkpoints
+	opts        Options
+	logger      *slog.Logger
+
+	files        atomic.Int64
+	lines        atomic.Int64
+	bytes        atomic.Int64
+	submitted    atomic.Int64
+	rejected     atomic.Int64
+	dropped      atomic.Int64
+	decodeErrors atomic.Int64
+}
+
+// NewIngester creates an ingester validating with service and storing through sink
+func NewIngester(service *ingestion.Service, sink ingestion.Sink, checkpoints *Checkpoints, opts Options) *Ingester {
+	defaults := DefaultOptions()
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaults.PollInterval
+	}
+	return &Ingester{
+		service:     service,
+		sink:        sink,
+		checkpoints: checkpoints,
+		opts:        opts,
+		logger:      slog.Default().With("component", "logfile"),
+	}
+}
+
+// Run ingests every file matching the patterns together with its rotated copies.
+// In follow mode it keeps polling for new content until the context is cancelled.
+func (i *Ingester) Run(ctx context.Context, patterns []string) error {
+	for {
+		err := i.scan(ctx, patterns)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !i.opts.Follow {
+			return err
+		}
+		if err != nil {
+			i.logger.Error("failed to ingest audit log files, retrying", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(i.opts.PollInterval):
+		}
+	}
+}
+
+// Stats returns a snapshot of the ingester counters
+func (i *Ingester) Stats() Stats {
+	return Stats{
+		Files:        i.files.Load(),
+		Lines:        i.lines.Load(),
+		Bytes:        i.bytes.Load(),
+		Submitted:    i.submitted.Load(),
+		Rejected:     i.rejected.Load(),
+		Dropped:      i.dropped.Load(),
+		DecodeErrors: i.decodeErrors.Load(),
+	}
+}
+
+func (i *Ingester) scan(ctx context.Context, patterns []string) error {
+	paths, err := discover(patterns)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := i.ingestFile(ctx, path); err != nil {
+			// Rotation may remove a file between discovery and reading it
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ingestFile reads the file from its checkpoint to the last complete line
+func (i *Ingester) ingestFile(ctx context.Context, path string) error {
+	fp, err := fingerprint(path, i.opts.Archived)
+	if err != nil || fp == "" {
+		return err
+	}
+	state := i.checkpoints.Get(fp)
+	if state.Complete {
+		return nil
+	}
+
+	// The file was truncated in place (copytruncate rotation)
+	if info, err := os.Stat(path); err == nil && info.Size() < state.Offset && state.Path == path {
+		state.Offset = 0
+	}
+
+	rc, compressed, err := openFile(path, state.Offset)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	i.files.Add(1)
+	i.logger.Debug("reading audit log file", "path", path, "offset", state.Offset, "compressed", compressed)
+
+	finished := compressed || i.opts.Archived
+	reader := bufio.NewReaderSize(rc, maxLineSize)
+	offset := state.Offset
+	batch := make([]auditv1.Event, 0, i.opts.BatchSize)
+	commit := func(complete bool) error {
+		if err := i.submit(ctx, batch); err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return i.checkpoints.Set(fp, FileState{Path: path, Offset: offset, Complete: complete})
+	}
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A trailing line without newline is still being written, unless the file is finished
+			if finished && len(line) > 0 {
+				offset += int64(len(line))
+				i.bytes.Add(int64(len(line)))
+				i.decode(path, line, &batch)
+			}
+			return commit(finished)
+		}
+		if err != nil {
+			return err
+		}
+
+		offset += int64(len(line))
+		i.bytes.Add(int64(len(line)))
+		i.decode(path, line, &batch)
+		if len(batch) >= i.opts.BatchSize {
+			if err := commit(false); err != nil {
+				return err
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// decode parses one line, skipping blank and malformed ones
+func (i *Ingester) decode(path string, line []byte, batch *[]auditv1.Event) {
+	event, err := ingestion.DecodeEvent(line)
+	if errors.Is(err, ingestion.ErrEmptyBody) {
+		return
+	}
+	i.lines.Add(1)
+	if err != nil {
+		i.decodeErrors.Add(1)
+		i.logger.Warn("skipping undecodable audit log line", "path", path, "error", err)
+		return
+	}
+	*batch = append(*batch, *event)
+}
+
+func (i *Ingester) submit(ctx context.Context, events []auditv1.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	accepted, result := i.service.Prepare(i.opts.Cluster, events)
+	i.rejected.Add(int64(len(result.Rejected)))
+	i.dropped.Add(int64(result.Dropped))
+	if err := i.sink.Submit(ctx, accepted); err != nil {
+		return err
+	}
+	i.submitted.Add(int64(len(accepted)))
+	return nil
+}
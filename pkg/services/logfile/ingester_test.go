@@ -0,0 +1,168 @@
+package logfile_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/logfile"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	dbName := fmt.Sprintf("file:logfile_%d_%d?mode=memory&cache=shared&_fk=1",
+		time.Now().UnixNano(), rand.Int63())
+	client := enttest.Open(t, "sqlite3", dbName)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func eventLines(t *testing.T, from, to int) string {
+	lines := ""
+	for i := from; i < to; i++ {
+		now := metav1.NewMicroTime(time.Now())
+		event := auditv1.Event{
+			TypeMeta:                 metav1.TypeMeta{APIVersion: "audit.k8s.io/v1", Kind: "Event"},
+			Level:                    auditv1.LevelMetadata,
+			AuditID:                  types.UID(fmt.Sprintf("event-%d", i)),
+			Stage:                    auditv1.StageResponseComplete,
+			Verb:                     "create",
+			UserAgent:                "kubectl/v1.30.0",
+			RequestReceivedTimestamp: now,
+			StageTimestamp:           now,
+		}
+		data, err := json.Marshal(event)
+		require.NoError(t, err)
+		lines += string(data) + "\n"
+	}
+	return lines
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func writeGzipFile(t *testing.T, path, content string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+}
+
+func newTestIngester(t *testing.T, client *ent.Client, checkpointPath string) *logfile.Ingester {
+	checkpoints, err := logfile.LoadCheckpoints(checkpointPath)
+	require.NoError(t, err)
+	service := ingestion.NewService(client)
+	return logfile.NewIngester(service, service, checkpoints, logfile.Options{BatchSize: 2})
+}
+
+func countEvents(t *testing.T, client *ent.Client) int {
+	count, err := client.AuditEvent.Query().Count(context.Background())
+	require.NoError(t, err)
+	return count
+}
+
+func TestIngester(t *testing.T) {
+	t.Run("should ingest every line of a plain file", func(t *testing.T) {
+		dir := t.TempDir()
+		client := setupTestDB(t)
+		writeFile(t, filepath.Join(dir, "audit.log"), eventLines(t, 0, 5)+"not json\n\n")
+
+		ingester := newTestIngester(t, client, filepath.Join(dir, "checkpoints.json"))
+		require.NoError(t, ingester.Run(context.Background(), []string{filepath.Join(dir, "audit.log")}))
+
+		assert.Equal(t, 5, countEvents(t, client))
+		stats := ingester.Stats()
+		assert.Equal(t, int64(5), stats.Submitted)
+		assert.Equal(t, int64(1), stats.DecodeErrors)
+	})
+
+	t.Run("should resume from the checkpoint after a restart", func(t *testing.T) {
+		dir := t.TempDir()
+		client := setupTestDB(t)
+		path := filepath.Join(dir, "audit.log")
+		checkpointPath := filepath.Join(dir, "checkpoints.json")
+		writeFile(t, path, eventLines(t, 0, 3))
+		require.NoError(t, newTestIngester(t, client, checkpointPath).Run(context.Background(), []string{path}))
+
+		// Append more events, including a partially written last line
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		require.NoError(t, err)
+		_, err = f.WriteString(eventLines(t, 3, 5) + `{"kind":"Ev`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		ingester := newTestIngester(t, client, checkpointPath)
+		require.NoError(t, ingester.Run(context.Background(), []string{path}))
+
+		assert.Equal(t, 5, countEvents(t, client))
+		assert.Equal(t, int64(2), ingester.Stats().Submitted)
+	})
+
+	t.Run("should read rotated and gzip-compressed files once", func(t *testing.T) {
+		dir := t.TempDir()
+		client := setupTestDB(t)
+		path := filepath.Join(dir, "audit.log")
+		checkpointPath := filepath.Join(dir, "checkpoints.json")
+
+		first := eventLines(t, 0, 3)
+		writeFile(t, path, first)
+		require.NoError(t, newTestIngester(t, client, checkpointPath).Run(context.Background(), []string{path}))
+
+		// Rotate: the active file is compressed under a timestamped name and a new one is started
+		writeGzipFile(t, filepath.Join(dir, "audit-2024-01-01T00-00-00.000.log.gz"), first+eventLines(t, 3, 4))
+		require.NoError(t, os.Remove(path))
+		writeGzipFile(t, filepath.Join(dir, "audit-2023-12-31T00-00-00.000.log.gz"), eventLines(t, 10, 12))
+		writeFile(t, path, eventLines(t, 4, 6))
+
+		ingester := newTestIngester(t, client, checkpointPath)
+		require.NoError(t, ingester.Run(context.Background(), []string{path}))
+
+		assert.Equal(t, 8, countEvents(t, client))
+		assert.Equal(t, int64(5), ingester.Stats().Submitted)
+	})
+
+	t.Run("should pick up new events in follow mode", func(t *testing.T) {
+		dir := t.TempDir()
+		client := setupTestDB(t)
+		path := filepath.Join(dir, "audit.log")
+		writeFile(t, path, eventLines(t, 0, 2))
+
+		checkpoints, err := logfile.LoadCheckpoints("")
+		require.NoError(t, err)
+		service := ingestion.NewService(client)
+		ingester := logfile.NewIngester(service, service, checkpoints, logfile.Options{Follow: true, PollInterval: 10 * time.Millisecond})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- ingester.Run(ctx, []string{path}) }()
+
+		require.Eventually(t, func() bool { return countEvents(t, client) == 2 }, 5*time.Second, 10*time.Millisecond)
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		require.NoError(t, err)
+		_, err = f.WriteString(eventLines(t, 2, 4))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.Eventually(t, func() bool { return countEvents(t, client) == 4 }, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+}
@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+)
+
+// DefaultDatabaseRefreshInterval is how long the database metrics are cached,
+// since counting the rows of large tables on every scrape would load the database
+const DefaultDatabaseRefreshInterval = time.Minute
+
+// databaseQueryTimeout bounds the queries of a refresh
+const databaseQueryTimeout = 10 * time.Second
+
+// Database collects the size of the database and the row counts of its tables
+type Database struct {
+	client   *ent.Client
+	driver   dialect.Driver
+	interval time.Duration
+	logger   *slog.Logger
+
+	size *prometheus.Desc
+	rows *prometheus.Desc
+
+	mu        sync.Mutex
+	refreshed time.Time
+	sizeBytes float64
+	counts    map[string]float64
+}
+
+var _ prometheus.Collector = (*Database)(nil)
+
+// NewDatabase creates a collector refreshing the database metrics at most once per interval
+func NewDatabase(client *ent.Client, driver dialect.Driver, interval time.Duration) *Database {
+	if interval <= 0 {
+		interval = DefaultDatabaseRefreshInterval
+	}
+	return &Database{
+		client:   client,
+		driver:   driver,
+		interval: interval,
+		logger:   slog.Default().With("component", "metrics"),
+		size:     prometheus.NewDesc("kad_database_size_bytes", "Space taken by the database, including its indexes.", nil, nil),
+		rows:     prometheus.NewDesc("kad_database_rows", "Rows stored, by table.", []string{"table"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (d *Database) Describe(ch chan<- *prometheus.Desc) {
+	ch <- d.size
+	ch <- d.rows
+}
+
+// Collect implements prometheus.Collector, reporting the last values when a refresh fails
+func (d *Database) Collect(ch chan<- prometheus.Metric) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if time.Since(d.refreshed) >= d.interval {
+		d.refresh()
+	}
+	if d.refreshed.IsZero() {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(d.size, prometheus.GaugeValue, d.sizeBytes)
+	for table, count := range d.counts {
+		ch <- prometheus.MustNewConstMetric(d.rows, prometheus.GaugeValue, count, table)
+	}
+}
+
+func (d *Database) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), databaseQueryTimeout)
+	defer cancel()
+
+	size, err := database.Size(ctx, d.driver)
+	if err != nil {
+		d.logger.Warn("failed to measure the database size", "error", err)
+		return
+	}
+	events, err := d.client.AuditEvent.Query().Count(ctx)
+	if err != nil {
+		d.logger.Warn("failed to count audit events", "error", err)
+		return
+	}
+	annotations, err := d.client.AuditAnnotation.Query().Count(ctx)
+	if err != nil {
+		d.logger.Warn("failed to count audit annotations", "error", err)
+		return
+	}
+	deadLetters, err := d.client.DeadLetter.Query().Count(ctx)
+	if err != nil {
+		d.logger.Warn("failed to count dead letters", "error", err)
+		return
+	}
+	d.sizeBytes = float64(size)
+	d.counts = map[string]float64{
+		"audit_events":      float64(events),
+		"audit_annotations": float64(annotations),
+		"dead_letters":      float64(deadLetters),
+	}
+	d.refreshed = time.Now()
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// GraphQL is a gqlgen extension measuring operations and the fields of the
+// root types, such as Query.resourceLifecycle
+type GraphQL struct {
+	operations  *prometheus.HistogramVec
+	fields      *prometheus.HistogramVec
+	fieldErrors *prometheus.CounterVec
+}
+
+var (
+	_ graphql.HandlerExtension    = (*GraphQL)(nil)
+	_ graphql.ResponseInterceptor = (*GraphQL)(nil)
+	_ graphql.FieldInterceptor    = (*GraphQL)(nil)
+)
+
+// NewGraphQL creates the GraphQL collectors and registers them
+func NewGraphQL(registerer prometheus.Registerer) *GraphQL {
+	g := &GraphQL{
+		operations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "kad",
+			Subsystem: "graphql",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of GraphQL operations, by type.",
+			Buckets:   prometheus.ExponentialBuckets(.005, 2, 12),
+		}, []string{"type"}),
+		fields: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "kad",
+			Subsystem: "graphql",
+			Name:      "field_duration_seconds",
+			Help:      "Duration of the resolvers of the root GraphQL fields.",
+			Buckets:   prometheus.ExponentialBuckets(.005, 2, 12),
+		}, []string{"object", "field"}),
+		fieldErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "kad",
+			Subsystem: "graphql",
+			Name:      "field_errors_total",
+			Help:      "Errors returned by the resolvers of the root GraphQL fields.",
+		}, []string{"object", "field"}),
+	}
+	registerer.MustRegister(g.operations, g.fields, g.fieldErrors)
+	return g
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (g *GraphQL) ExtensionName() string {
+	return "PrometheusMetrics"
+}
+
+// Validate implements graphql.HandlerExtension
+func (g *GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor
+func (g *GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	start := time.Now()
+	response := next(ctx)
+	if graphql.HasOperationContext(ctx) {
+		if operation := graphql.GetOperationContext(ctx).Operation; operation != nil {
+			g.operations.WithLabelValues(string(operation.Operation)).Observe(time.Since(start).Seconds())
+		}
+	}
+	return response
+}
+
+// InterceptField implements graphql.FieldInterceptor
+func (g *GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !isRootObject(fc.Object) {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	g.fields.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		g.fieldErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
+
+func isRootObject(object string) bool {
+	return object == "Query" || object == "Mutation" || object == "Subscription"
+}
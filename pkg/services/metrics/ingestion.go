@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+)
+
+// Ingestion collects the outcome of webhook authentication and the events
+// dropped by the ingest filter and the sampler, from the counters behind their
+// stats endpoints. The filter and the sampler are nil when not configured.
+type Ingestion struct {
+	authenticator *ingestion.Authenticator
+	filter        *auditfilter.Filter
+	sampler       *sampling.Sampler
+
+	authenticated *prometheus.Desc
+	rejected      *prometheus.Desc
+	filtered      *prometheus.Desc
+	downgraded    *prometheus.Desc
+	sampled       *prometheus.Desc
+	sampledOut    *prometheus.Desc
+	samplingKeys  *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*Ingestion)(nil)
+
+// NewIngestion creates a collector reading the counters of the authenticator, the filter and the sampler
+func NewIngestion(authenticator *ingestion.Authenticator, filter *auditfilter.Filter, sampler *sampling.Sampler) *Ingestion {
+	return &Ingestion{
+		authenticator: authenticator,
+		filter:        filter,
+		sampler:       sampler,
+		authenticated: prometheus.NewDesc("kad_ingestion_auth_authenticated_total", "Webhook requests carrying a valid credential.", nil, nil),
+		rejected:      prometheus.NewDesc("kad_ingestion_auth_rejected_total", "Webhook requests rejected by authentication, by reason.", []string{"reason"}, nil),
+		filtered:      prometheus.NewDesc("kad_ingestion_filter_dropped_events_total", "Events dropped by the ingest filter policy.", nil, nil),
+		downgraded:    prometheus.NewDesc("kad_ingestion_filter_downgraded_events_total", "Events whose level was lowered by the ingest filter policy.", nil, nil),
+		sampled:       prometheus.NewDesc("kad_ingestion_sampling_evaluated_events_total", "Events with a sampled verb.", nil, nil),
+		sampledOut:    prometheus.NewDesc("kad_ingestion_sampling_dropped_events_total", "Events dropped by read sampling.", nil, nil),
+		samplingKeys:  prometheus.NewDesc("kad_ingestion_sampling_keys", "Sampling keys currently remembered.", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (i *Ingestion) Describe(ch chan<- *prometheus.Desc) {
+	ch <- i.authenticated
+	ch <- i.rejected
+	ch <- i.filtered
+	ch <- i.downgraded
+	ch <- i.sampled
+	ch <- i.sampledOut
+	ch <- i.samplingKeys
+}
+
+// Collect implements prometheus.Collector
+func (i *Ingestion) Collect(ch chan<- prometheus.Metric) {
+	auth := i.authenticator.Stats()
+	ch <- prometheus.MustNewConstMetric(i.authenticated, prometheus.CounterValue, float64(auth.Authenticated))
+	for _, reason := range []string{ingestion.RejectMissingCredentials, ingestion.RejectInvalidToken, ingestion.RejectInvalidCertificate} {
+		ch <- prometheus.MustNewConstMetric(i.rejected, prometheus.CounterValue, float64(auth.Rejected[reason]), reason)
+	}
+	if i.filter != nil {
+		stats := i.filter.Stats()
+		ch <- prometheus.MustNewConstMetric(i.filtered, prometheus.CounterValue, float64(stats.Dropped))
+		ch <- prometheus.MustNewConstMetric(i.downgraded, prometheus.CounterValue, float64(stats.Downgraded))
+	}
+	if i.sampler != nil {
+		stats := i.sampler.Stats()
+		ch <- prometheus.MustNewConstMetric(i.sampled, prometheus.CounterValue, float64(stats.Evaluated))
+		ch <- prometheus.MustNewConstMetric(i.sampledOut, prometheus.CounterValue, float64(stats.Dropped))
+		ch <- prometheus.MustNewConstMetric(i.samplingKeys, prometheus.GaugeValue, float64(stats.Keys))
+	}
+}
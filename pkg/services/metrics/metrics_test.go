@@ -0,0 +1,131 @@
+package metrics_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/auditfilter"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/database"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/metrics"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+func setupTestDB(t *testing.T) (*ent.Client, dialect.Driver) {
+	dsn := fmt.Sprintf("file:metrics_%d_%d?mode=memory&cache=shared&_fk=1",
+		time.Now().UnixNano(), rand.Int63())
+	client, driver, err := database.Open(dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	require.NoError(t, database.Migrate(context.Background(), client))
+	return client, driver
+}
+
+func scrape(t *testing.T, handler gin.HandlerFunc) string {
+	gin.SetMode(gin.TestMode)
+	app := gin.New()
+	app.GET("/metrics", handler)
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	return recorder.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("should expose runtime and database metrics", func(t *testing.T) {
+		ctx := context.Background()
+		client, driver := setupTestDB(t)
+		require.NoError(t, client.AuditEvent.Create().
+			SetRaw(`{}`).SetLevel("Metadata").SetAuditID("a").SetVerb("get").SetUserAgent("kubectl").
+			SetRequestTimestamp(time.Now()).SetStageTimestamp(time.Now()).SetStage("ResponseComplete").
+			Exec(ctx))
+
+		registry := metrics.NewRegistry()
+		collector := metrics.NewDatabase(client, driver, time.Hour)
+		registry.MustRegister(collector)
+
+		body := scrape(t, metrics.NewHandler(registry))
+		assert.Contains(t, body, "go_goroutines")
+		assert.Contains(t, body, `kad_database_rows{table="audit_events"} 1`)
+		assert.Contains(t, body, `kad_database_rows{table="audit_annotations"} 0`)
+		assert.Contains(t, body, `kad_database_rows{table="dead_letters"} 0`)
+		assert.Contains(t, body, "kad_database_size_bytes")
+
+		// Values are cached for the refresh interval
+		require.NoError(t, client.AuditEvent.Create().
+			SetRaw(`{}`).SetLevel("Metadata").SetAuditID("b").SetVerb("get").SetUserAgent("kubectl").
+			SetRequestTimestamp(time.Now()).SetStageTimestamp(time.Now()).SetStage("ResponseComplete").
+			Exec(ctx))
+		assert.Contains(t, scrape(t, metrics.NewHandler(registry)), `kad_database_rows{table="audit_events"} 1`)
+	})
+}
+
+func TestIngestion(t *testing.T) {
+	t.Run("should expose auth rejections, filter drops and sampler drops", func(t *testing.T) {
+		authenticator := ingestion.NewAuthenticator(&ingestion.Credentials{Tokens: []ingestion.ClusterToken{{Token: "prod-token"}}}, nil)
+		gin.SetMode(gin.TestMode)
+		app := gin.New()
+		app.POST("/api/audit-webhook", authenticator.Middleware(), func(c *gin.Context) { c.Status(http.StatusOK) })
+		for _, header := range []string{"", "Bearer wrong", "Bearer wrong-again", "Bearer prod-token"} {
+			req := httptest.NewRequest(http.MethodPost, "/api/audit-webhook", nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			app.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		filter := auditfilter.NewFilter(&auditinternal.Policy{Rules: []auditinternal.PolicyRule{
+			{Level: auditinternal.LevelNone, Verbs: []string{"watch"}},
+			{Level: auditinternal.LevelMetadata},
+		}})
+		sampler := sampling.NewSampler(sampling.Config{PerMinute: 1})
+		for i, verb := range []string{"watch", "get", "get", "get"} {
+			entry := ingestion.Entry{Event: auditv1.Event{
+				Level:                    auditv1.LevelRequestResponse,
+				AuditID:                  types.UID(fmt.Sprint(i)),
+				Verb:                     verb,
+				RequestReceivedTimestamp: metav1.NewMicroTime(time.Now()),
+				ObjectRef:                &auditv1.ObjectReference{Resource: "pods", APIVersion: "v1"},
+			}}
+			if filter.Process(&entry) {
+				sampler.Process(&entry)
+			}
+		}
+
+		registry := metrics.NewRegistry()
+		registry.MustRegister(metrics.NewIngestion(authenticator, filter, sampler))
+		body := scrape(t, metrics.NewHandler(registry))
+		assert.Contains(t, body, "kad_ingestion_auth_authenticated_total 1")
+		assert.Contains(t, body, `kad_ingestion_auth_rejected_total{reason="missing_credentials"} 1`)
+		assert.Contains(t, body, `kad_ingestion_auth_rejected_total{reason="invalid_token"} 2`)
+		assert.Contains(t, body, `kad_ingestion_auth_rejected_total{reason="invalid_certificate"} 0`)
+		assert.Contains(t, body, "kad_ingestion_filter_dropped_events_total 1")
+		assert.Contains(t, body, "kad_ingestion_filter_downgraded_events_total 3")
+		assert.Contains(t, body, "kad_ingestion_sampling_evaluated_events_total 3")
+		assert.Contains(t, body, "kad_ingestion_sampling_dropped_events_total 2")
+		assert.Contains(t, body, "kad_ingestion_sampling_keys 1")
+	})
+
+	t.Run("should leave out the filter and sampler when not configured", func(t *testing.T) {
+		registry := metrics.NewRegistry()
+		registry.MustRegister(metrics.NewIngestion(ingestion.NewAuthenticator(nil, nil), nil, nil))
+		body := scrape(t, metrics.NewHandler(registry))
+		assert.Contains(t, body, "kad_ingestion_auth_authenticated_total 0")
+		assert.NotContains(t, body, "kad_ingestion_filter_")
+		assert.NotContains(t, body, "kad_ingestion_sampling_")
+	})
+}
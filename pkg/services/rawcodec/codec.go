@@ -0,0 +1,178 @@
+package rawcodec
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent/schema/field"
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdMagic starts every zstd frame; plain raw payloads are JSON objects starting with '{'
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// Options configures how raw payloads are written. Reading always accepts both
+// plain and compressed payloads, so rows written before compression was enabled
+// stay readable.
+type Options struct {
+	// Disabled stores new payloads uncompressed
+	Disabled bool
+	// Level is the zstd encoder level
+	Level zstd.EncoderLevel
+	// Dictionary is a zstd dictionary, as built by TrainDictionary, shared by all payloads.
+	// Payloads compressed with a dictionary can only be read while it stays configured.
+	Dictionary []byte
+	// Keyring encrypts new payloads with its primary key when set. Encrypted
+	// payloads can only be read while their key stays in the keyring.
+	Keyring *Keyring
+}
+
+// DefaultOptions returns the options used when nothing is configured
+func DefaultOptions() Options {
+	return Options{Level: zstd.SpeedDefault}
+}
+
+type codec struct {
+	disabled   bool
+	encoder    *zstd.Encoder
+	decoder    *zstd.Decoder
+	encryption *encryption
+}
+
+var (
+	mu      sync.RWMutex
+	current *codec
+)
+
+func init() {
+	if err := Configure(DefaultOptions()); err != nil {
+		panic(err)
+	}
+}
+
+// Configure replaces the process-wide codec used by the raw column of AuditEvent
+func Configure(opts Options) error {
+	if opts.Level == 0 {
+		opts.Level = zstd.SpeedDefault
+	}
+	encoderOptions := []zstd.EOption{zstd.WithEncoderLevel(opts.Level), zstd.WithEncoderConcurrency(1)}
+	decoderOptions := []zstd.DOption{zstd.WithDecoderConcurrency(0)}
+	if len(opts.Dictionary) > 0 {
+		encoderOptions = append(encoderOptions, zstd.WithEncoderDict(opts.Dictionary))
+		decoderOptions = append(decoderOptions, zstd.WithDecoderDicts(opts.Dictionary))
+	}
+	encoder, err := zstd.NewWriter(nil, encoderOptions...)
+	if err != nil {
+		return fmt.Errorf("invalid raw compression options: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil, decoderOptions...)
+	if err != nil {
+		return fmt.Errorf("invalid raw compression dictionary: %w", err)
+	}
+	c := &codec{disabled: opts.Disabled, encoder: encoder, decoder: decoder}
+	if opts.Keyring != nil {
+		if c.encryption, err = newEncryption(opts.Keyring); err != nil {
+			return err
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	current = c
+	return nil
+}
+
+func get() *codec {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Enabled reports whether new payloads are stored compressed
+func Enabled() bool {
+	return !get().disabled
+}
+
+// KeyID returns the ID of the key encrypting new payloads, empty when encryption is disabled
+func KeyID() string {
+	if e := get().encryption; e != nil {
+		return e.primary
+	}
+	return ""
+}
+
+// Encode compresses then encrypts a raw payload as configured, as stored in the database
+func Encode(raw string) ([]byte, error) {
+	stored := Compress(raw)
+	if e := get().encryption; e != nil {
+		return e.seal(stored)
+	}
+	return stored, nil
+}
+
+// Decode decrypts and decompresses a stored payload, whatever the options it was stored with
+func Decode(stored []byte) (string, error) {
+	if IsEncrypted(stored) {
+		payload, err := get().encryption.open(stored)
+		if err != nil {
+			return "", err
+		}
+		stored = payload
+	}
+	return Decompress(stored)
+}
+
+// Compress compresses a raw payload as configured, without encrypting it
+func Compress(raw string) []byte {
+	c := get()
+	if c.disabled {
+		return []byte(raw)
+	}
+	return c.encoder.EncodeAll([]byte(raw), nil)
+}
+
+// Decompress decodes a stored payload, which may be compressed or not
+func Decompress(stored []byte) (string, error) {
+	if !IsCompressed(stored) {
+		return string(stored), nil
+	}
+	raw, err := get().decoder.DecodeAll(stored, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress raw payload: %w", err)
+	}
+	return string(raw), nil
+}
+
+// IsCompressed reports whether a stored payload is zstd compressed
+func IsCompressed(stored []byte) bool {
+	return bytes.HasPrefix(stored, zstdMagic)
+}
+
+// ValueScanner stores the raw column of AuditEvent compressed and encrypted,
+// while ent and GraphQL consumers keep seeing the plain JSON string
+type ValueScanner struct{}
+
+// Value implements field.TypeValueScanner
+func (ValueScanner) Value(raw string) (driver.Value, error) {
+	return Encode(raw)
+}
+
+// ScanValue implements field.TypeValueScanner
+func (ValueScanner) ScanValue() field.ValueScanner {
+	return &sql.NullString{}
+}
+
+// FromValue implements field.TypeValueScanner
+func (ValueScanner) FromValue(v driver.Value) (string, error) {
+	s, ok := v.(*sql.NullString)
+	if !ok {
+		return "", fmt.Errorf("unexpected raw column value %T", v)
+	}
+	if !s.Valid {
+		return "", nil
+	}
+	return Decode([]byte(s.String))
+}
@@ -0,0 +1,68 @@
+package rawcodec_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+func configure(t *testing.T, opts rawcodec.Options) {
+	require.NoError(t, rawcodec.Configure(opts))
+	t.Cleanup(func() { require.NoError(t, rawcodec.Configure(rawcodec.DefaultOptions())) })
+}
+
+func samplePayload(i int) string {
+	return fmt.Sprintf(`{"kind":"Event","apiVersion":"audit.k8s.io/v1","level":"RequestResponse","auditID":"%08d-0000-0000-0000-000000000000","stage":"ResponseComplete","requestURI":"/api/v1/namespaces/default/configmaps/config-%d","verb":"update","user":{"username":"system:serviceaccount:kube-system:replicaset-controller","groups":["system:serviceaccounts","system:serviceaccounts:kube-system","system:authenticated"]},"sourceIPs":["10.0.0.%d"],"userAgent":"kube-controller-manager/v1.30.0 (linux/amd64) kubernetes/7c48c2b"}`, i, i, i%255)
+}
+
+func TestCodec(t *testing.T) {
+	t.Run("should round trip compressed payloads", func(t *testing.T) {
+		raw := strings.Repeat(samplePayload(1), 10)
+		stored := rawcodec.Compress(raw)
+		assert.True(t, rawcodec.IsCompressed(stored))
+		assert.Less(t, len(stored), len(raw))
+
+		decoded, err := rawcodec.Decompress(stored)
+		require.NoError(t, err)
+		assert.Equal(t, raw, decoded)
+	})
+
+	t.Run("should read plain payloads stored before compression", func(t *testing.T) {
+		decoded, err := rawcodec.Decompress([]byte(samplePayload(1)))
+		require.NoError(t, err)
+		assert.Equal(t, samplePayload(1), decoded)
+	})
+
+	t.Run("should store plain payloads when disabled", func(t *testing.T) {
+		configure(t, rawcodec.Options{Disabled: true})
+		assert.Equal(t, []byte(samplePayload(1)), rawcodec.Compress(samplePayload(1)))
+	})
+
+	t.Run("should compress better with a trained dictionary", func(t *testing.T) {
+		samples := make([][]byte, 0, 200)
+		for i := 0; i < 200; i++ {
+			samples = append(samples, []byte(samplePayload(i)))
+		}
+		dictionary, err := rawcodec.TrainDictionary(samples, 16*1024)
+		require.NoError(t, err)
+
+		payload := samplePayload(1000)
+		withoutDictionary := rawcodec.Compress(payload)
+		configure(t, rawcodec.Options{Dictionary: dictionary})
+		withDictionary := rawcodec.Compress(payload)
+		assert.Less(t, len(withDictionary), len(withoutDictionary))
+
+		decoded, err := rawcodec.Decompress(withDictionary)
+		require.NoError(t, err)
+		assert.Equal(t, payload, decoded)
+
+		// Payloads compressed without the dictionary stay readable
+		decoded, err = rawcodec.Decompress(withoutDictionary)
+		require.NoError(t, err)
+		assert.Equal(t, payload, decoded)
+	})
+}
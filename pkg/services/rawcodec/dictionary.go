@@ -0,0 +1,28 @@
+package rawcodec
+
+import (
+	"errors"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+)
+
+// DefaultDictionarySize is the size of dictionaries built by TrainDictionary
+const DefaultDictionarySize = 112 * 1024
+
+// TrainDictionary builds a zstd dictionary from sample raw payloads. Audit events
+// share most of their structure, so a dictionary noticeably improves the ratio
+// of small payloads that zstd alone compresses poorly.
+func TrainDictionary(samples [][]byte, size int) ([]byte, error) {
+	if len(samples) == 0 {
+		return nil, errors.New("no samples to train a dictionary from")
+	}
+	if size <= 0 {
+		size = DefaultDictionarySize
+	}
+	return dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: size,
+		HashBytes:   6,
+		ZstdLevel:   zstd.SpeedDefault,
+	})
+}
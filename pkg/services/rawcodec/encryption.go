@@ -0,0 +1,239 @@
+package rawcodec
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// KeySize is the size of the AES-256 keys of a keyring
+const KeySize = 32
+
+// envelopeMagic starts every encrypted payload, followed by the format version
+var envelopeMagic = []byte{'K', 'A', 'D', 'E', 1}
+
+// Key is an AES-256 key encrypting the data keys of raw payloads
+type Key struct {
+	ID     string `json:"id"`
+	Secret []byte `json:"secret"`
+}
+
+// Keyring holds the keys raw payloads were encrypted with. New payloads are
+// encrypted with the primary key, the others are kept to read older rows until
+// rotate-key re-encrypted them.
+type Keyring struct {
+	// Primary is the ID of the key encrypting new payloads, the last key when empty
+	Primary string `json:"primary,omitempty"`
+	Keys    []Key  `json:"keys"`
+}
+
+// LoadKeyring reads a YAML keyring file
+func LoadKeyring(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read raw encryption keyring: %w", err)
+	}
+	var keyring Keyring
+	if err := yaml.UnmarshalStrict(data, &keyring); err != nil {
+		return nil, fmt.Errorf("parse raw encryption keyring %s: %w", path, err)
+	}
+	if err := keyring.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid raw encryption keyring %s: %w", path, err)
+	}
+	return &keyring, nil
+}
+
+// Save writes the keyring to a file only readable by its owner
+func (k *Keyring) Save(path string) error {
+	data, err := yaml.Marshal(k)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Validate checks the keys and that the primary key exists
+func (k *Keyring) Validate() error {
+	if len(k.Keys) == 0 {
+		return errors.New("no keys")
+	}
+	var errs []error
+	seen := map[string]bool{}
+	for i, key := range k.Keys {
+		if key.ID == "" || len(key.ID) > 255 {
+			errs = append(errs, fmt.Errorf("key %d: id must be 1 to 255 bytes", i))
+		}
+		if seen[key.ID] {
+			errs = append(errs, fmt.Errorf("key %d: duplicated id %q", i, key.ID))
+		}
+		seen[key.ID] = true
+		if len(key.Secret) != KeySize {
+			errs = append(errs, fmt.Errorf("key %q: secret must be %d bytes, base64 encoded", key.ID, KeySize))
+		}
+	}
+	if k.Primary != "" && !seen[k.Primary] {
+		errs = append(errs, fmt.Errorf("primary key %q not found", k.Primary))
+	}
+	return errors.Join(errs...)
+}
+
+// PrimaryID returns the ID of the key encrypting new payloads
+func (k *Keyring) PrimaryID() string {
+	if k.Primary != "" {
+		return k.Primary
+	}
+	return k.Keys[len(k.Keys)-1].ID
+}
+
+// Generate adds a random key named after the current time and makes it primary
+func (k *Keyring) Generate() (Key, error) {
+	key := Key{ID: time.Now().UTC().Format("20060102T150405Z"), Secret: make([]byte, KeySize)}
+	if slices.ContainsFunc(k.Keys, func(existing Key) bool { return existing.ID == key.ID }) {
+		return Key{}, fmt.Errorf("key %q already exists", key.ID)
+	}
+	if _, err := rand.Read(key.Secret); err != nil {
+		return Key{}, fmt.Errorf("generate raw encryption key: %w", err)
+	}
+	k.Keys = append(k.Keys, key)
+	k.Primary = key.ID
+	return key, nil
+}
+
+// encryption seals payloads in envelopes: each payload is encrypted with its own
+// random data key, itself encrypted with a key of the keyring. Both use AES-GCM
+// authenticated with the envelope header, which holds the key ID.
+type encryption struct {
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+func newEncryption(keyring *Keyring) (*encryption, error) {
+	if err := keyring.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid raw encryption keyring: %w", err)
+	}
+	e := &encryption{primary: keyring.PrimaryID(), aeads: map[string]cipher.AEAD{}}
+	for _, key := range keyring.Keys {
+		aead, err := newAEAD(key.Secret)
+		if err != nil {
+			return nil, err
+		}
+		e.aeads[key.ID] = aead
+	}
+	return e, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the envelope of the payload:
+// magic, version, key ID length, key ID, nonce and sealed data key, nonce and sealed payload
+func (e *encryption) seal(payload []byte) ([]byte, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	keyAEAD := e.aeads[e.primary]
+
+	header := append(append(slices.Clip(envelopeMagic), byte(len(e.primary))), e.primary...)
+	envelope := slices.Grow(header, 2*(keyAEAD.NonceSize()+keyAEAD.Overhead())+KeySize+len(payload))
+	envelope, err = appendSealed(envelope, keyAEAD, dataKey, header)
+	if err != nil {
+		return nil, err
+	}
+	return appendSealed(envelope, dataAEAD, payload, header)
+}
+
+func appendSealed(dst []byte, aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	dst = append(dst, nonce...)
+	return aead.Seal(dst, nonce, plaintext, additionalData), nil
+}
+
+// open returns the payload of an envelope
+func (e *encryption) open(envelope []byte) ([]byte, error) {
+	keyID, header, rest, err := parseEnvelope(envelope)
+	if err != nil {
+		return nil, err
+	}
+	var keyAEAD cipher.AEAD
+	if e != nil {
+		keyAEAD = e.aeads[keyID]
+	}
+	if keyAEAD == nil {
+		return nil, fmt.Errorf("raw payload encrypted with key %q, which is not in the keyring", keyID)
+	}
+
+	sealedKeySize := keyAEAD.NonceSize() + KeySize + keyAEAD.Overhead()
+	if len(rest) < sealedKeySize {
+		return nil, errors.New("truncated encrypted raw payload")
+	}
+	dataKey, err := openSealed(keyAEAD, rest[:sealedKeySize], header)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt data key of raw payload with key %q: %w", keyID, err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := openSealed(dataAEAD, rest[sealedKeySize:], header)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt raw payload: %w", err)
+	}
+	return payload, nil
+}
+
+func openSealed(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("truncated encrypted raw payload")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// parseEnvelope splits an envelope into its key ID, its header and the sealed keys and payload
+func parseEnvelope(envelope []byte) (keyID string, header, rest []byte, err error) {
+	if !IsEncrypted(envelope) || len(envelope) <= len(envelopeMagic) {
+		return "", nil, nil, errors.New("not an encrypted raw payload")
+	}
+	headerSize := len(envelopeMagic) + 1 + int(envelope[len(envelopeMagic)])
+	if len(envelope) < headerSize {
+		return "", nil, nil, errors.New("truncated encrypted raw payload")
+	}
+	header = envelope[:headerSize]
+	return string(header[len(envelopeMagic)+1:]), header, envelope[headerSize:], nil
+}
+
+// IsEncrypted reports whether a stored payload is encrypted
+func IsEncrypted(stored []byte) bool {
+	return bytes.HasPrefix(stored, envelopeMagic)
+}
+
+// StoredKeyID returns the ID of the key a stored payload is encrypted with,
+// empty when it is not encrypted
+func StoredKeyID(stored []byte) string {
+	keyID, _, _, err := parseEnvelope(stored)
+	if err != nil {
+		return ""
+	}
+	return keyID
+}
@@ -0,0 +1,109 @@
+package rawcodec_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/rawcodec"
+)
+
+func newKeyring(t *testing.T, keys int) *rawcodec.Keyring {
+	keyring := &rawcodec.Keyring{}
+	for i := 0; i < keys; i++ {
+		key, err := keyring.Generate()
+		require.NoError(t, err)
+		// Generated IDs have a one second resolution
+		keyring.Keys[len(keyring.Keys)-1].ID = key.ID + string(rune('a'+i))
+		keyring.Primary = keyring.Keys[len(keyring.Keys)-1].ID
+	}
+	return keyring
+}
+
+func TestEncryption(t *testing.T) {
+	t.Run("should round trip encrypted payloads", func(t *testing.T) {
+		keyring := newKeyring(t, 1)
+		configure(t, rawcodec.Options{Keyring: keyring})
+		assert.Equal(t, keyring.PrimaryID(), rawcodec.KeyID())
+
+		stored, err := rawcodec.Encode(samplePayload(1))
+		require.NoError(t, err)
+		assert.True(t, rawcodec.IsEncrypted(stored))
+		assert.NotContains(t, string(stored), "replicaset-controller")
+		assert.Equal(t, keyring.PrimaryID(), rawcodec.StoredKeyID(stored))
+
+		decoded, err := rawcodec.Decode(stored)
+		require.NoError(t, err)
+		assert.Equal(t, samplePayload(1), decoded)
+
+		again, err := rawcodec.Encode(samplePayload(1))
+		require.NoError(t, err)
+		assert.NotEqual(t, stored, again)
+	})
+
+	t.Run("should read payloads of every key in the keyring", func(t *testing.T) {
+		keyring := newKeyring(t, 2)
+		keyring.Primary = keyring.Keys[0].ID
+		configure(t, rawcodec.Options{Keyring: keyring, Disabled: true})
+		old, err := rawcodec.Encode(samplePayload(1))
+		require.NoError(t, err)
+
+		keyring.Primary = keyring.Keys[1].ID
+		configure(t, rawcodec.Options{Keyring: keyring})
+		decoded, err := rawcodec.Decode(old)
+		require.NoError(t, err)
+		assert.Equal(t, samplePayload(1), decoded)
+		assert.Equal(t, keyring.Keys[0].ID, rawcodec.StoredKeyID(old))
+
+		// Plain and compressed payloads stored before encryption stay readable
+		decoded, err = rawcodec.Decode(rawcodec.Compress(samplePayload(2)))
+		require.NoError(t, err)
+		assert.Equal(t, samplePayload(2), decoded)
+	})
+
+	t.Run("should fail without the key or when tampered with", func(t *testing.T) {
+		configure(t, rawcodec.Options{Keyring: newKeyring(t, 1)})
+		stored, err := rawcodec.Encode(samplePayload(1))
+		require.NoError(t, err)
+
+		tampered := append([]byte{}, stored...)
+		tampered[len(tampered)-1] ^= 1
+		_, err = rawcodec.Decode(tampered)
+		assert.Error(t, err)
+
+		other := newKeyring(t, 1)
+		other.Keys[0].ID, other.Primary = "other", "other"
+		configure(t, rawcodec.Options{Keyring: other})
+		_, err = rawcodec.Decode(stored)
+		assert.ErrorContains(t, err, "not in the keyring")
+
+		configure(t, rawcodec.DefaultOptions())
+		_, err = rawcodec.Decode(stored)
+		assert.ErrorContains(t, err, "not in the keyring")
+	})
+
+	t.Run("should save and load keyrings", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keyring.yaml")
+		keyring := newKeyring(t, 2)
+		require.NoError(t, keyring.Save(path))
+
+		loaded, err := rawcodec.LoadKeyring(path)
+		require.NoError(t, err)
+		assert.Equal(t, keyring, loaded)
+	})
+
+	t.Run("should reject invalid keyrings", func(t *testing.T) {
+		assert.Error(t, (&rawcodec.Keyring{}).Validate())
+		assert.Error(t, (&rawcodec.Keyring{Keys: []rawcodec.Key{{ID: "a", Secret: []byte("short")}}}).Validate())
+
+		keyring := newKeyring(t, 1)
+		keyring.Primary = "missing"
+		assert.ErrorContains(t, keyring.Validate(), "missing")
+
+		keyring = newKeyring(t, 1)
+		keyring.Keys = append(keyring.Keys, keyring.Keys[0])
+		assert.ErrorContains(t, keyring.Validate(), "duplicated")
+		assert.Error(t, rawcodec.Configure(rawcodec.Options{Keyring: keyring}))
+	})
+}
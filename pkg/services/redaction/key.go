@@ -0,0 +1,62 @@
+package redaction
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// MinKeySize is the minimum length of a redaction key in bytes
+const MinKeySize = 16
+
+// LoadOrCreateKey reads the redaction key from the file, generating a random
+// one when the file does not exist so that hashes stay comparable across
+// restarts. It reports whether the key was created.
+func LoadOrCreateKey(path string) ([]byte, bool, error) {
+	content, err := os.ReadFile(path)
+	if err == nil {
+		key := bytes.TrimSpace(content)
+		if len(key) < MinKeySize {
+			return nil, false, fmt.Errorf("redaction key %s must be at least %d bytes", path, MinKeySize)
+		}
+		return key, false, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, false, fmt.Errorf("read redaction key: %w", err)
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, false, fmt.Errorf("generate redaction key: %w", err)
+	}
+	key := []byte(hex.EncodeToString(random))
+	if err := os.WriteFile(path, append(key, '\n'), 0o600); err != nil {
+		return nil, false, fmt.Errorf("write redaction key: %w", err)
+	}
+	return key, true, nil
+}
+
+// CheckKey checks the key file LoadOrCreateKey would read, or when it does not
+// exist, that the directory it would be created in exists, without writing it
+func CheckKey(path string) error {
+	content, err := os.ReadFile(path)
+	if err == nil {
+		if len(bytes.TrimSpace(content)) < MinKeySize {
+			return fmt.Errorf("redaction key %s must be at least %d bytes", path, MinKeySize)
+		}
+		return nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("read redaction key: %w", err)
+	}
+	dir := filepath.Dir(path)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("redaction key %s cannot be created: %s is not a directory", path, dir)
+	}
+	return nil
+}
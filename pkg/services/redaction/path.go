@@ -0,0 +1,110 @@
+package redaction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// wildcard matches every key of an object or every element of an array
+	wildcard = "*"
+	// elements matches every element of an array only
+	elements = "[*]"
+)
+
+// Path is a parsed JSONPath selecting the values to redact. It supports field
+// access (.data), quoted keys (['kubectl.kubernetes.io/last-applied-configuration']),
+// array indexes ([0]) and wildcards: .* selects every key of an object or
+// element of an array, [*] every element of an array only.
+type Path struct {
+	raw      string
+	segments []string
+}
+
+// ParsePath parses a JSONPath such as .data.* or .metadata.annotations['example.com/token']
+func ParsePath(path string) (*Path, error) {
+	p := &Path{raw: path}
+	rest := strings.TrimPrefix(strings.TrimPrefix(path, "$"), "{")
+	rest = strings.TrimSuffix(rest, "}")
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			segment := rest[1 : end+1]
+			if segment == "" {
+				return nil, fmt.Errorf("invalid path %q: empty field name", path)
+			}
+			p.segments = append(p.segments, segment)
+			rest = rest[end+1:]
+		case '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unterminated bracket", path)
+			}
+			segment := rest[1:end]
+			if segment == wildcard {
+				segment = elements
+			} else if unquoted, err := strconv.Unquote(strings.ReplaceAll(segment, "'", `"`)); err == nil {
+				segment = unquoted
+			} else if _, err := strconv.Atoi(segment); err != nil {
+				return nil, fmt.Errorf("invalid path %q: bracket must hold a quoted key, an index or *", path)
+			}
+			p.segments = append(p.segments, segment)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected . or [", path)
+		}
+	}
+	if len(p.segments) == 0 {
+		return nil, fmt.Errorf("invalid path %q: selects the whole object", path)
+	}
+	return p, nil
+}
+
+// String returns the path as written
+func (p *Path) String() string {
+	return p.raw
+}
+
+// apply replaces every value selected by the path in the decoded JSON document,
+// returning how many values were replaced
+func (p *Path) apply(document any, replace func(any) any) int {
+	return applySegments(document, p.segments, replace)
+}
+
+func applySegments(node any, segments []string, replace func(any) any) int {
+	segment, last := segments[0], len(segments) == 1
+	count := 0
+	visit := func(value any, set func(any)) {
+		if last {
+			set(replace(value))
+			count++
+			return
+		}
+		count += applySegments(value, segments[1:], replace)
+	}
+
+	switch typed := node.(type) {
+	case map[string]any:
+		if segment == wildcard {
+			for key, value := range typed {
+				visit(value, func(v any) { typed[key] = v })
+			}
+		} else if value, ok := typed[segment]; ok {
+			visit(value, func(v any) { typed[segment] = v })
+		}
+	case []any:
+		if segment == wildcard || segment == elements {
+			for i, value := range typed {
+				visit(value, func(v any) { typed[i] = v })
+			}
+		} else if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(typed) {
+			visit(typed[i], func(v any) { typed[i] = v })
+		}
+	}
+	return count
+}
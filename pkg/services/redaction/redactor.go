@@ -0,0 +1,197 @@
+package redaction
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"k8s.io/apimachinery/pkg/runtime"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// Prefix starts every value written in place of a redacted one
+const Prefix = "redacted:hmac-sha256:"
+
+// hashSize is the number of bytes of the HMAC kept in a redacted value, enough
+// to tell two values apart without bloating the stored bodies
+const hashSize = 12
+
+// Rule lists the fields to redact from the request and response bodies of a
+// resource. An empty version matches every version of the group.
+type Rule struct {
+	Group       string   `json:"group"`
+	Version     string   `json:"version,omitempty"`
+	Resource    string   `json:"resource"`
+	Subresource string   `json:"subresource,omitempty"`
+	Paths       []string `json:"paths"`
+}
+
+// DefaultRules returns the rules always applied by a redactor: the data of
+// Secrets, including the copy kept in the last applied configuration, and the
+// bearer tokens of TokenReviews and TokenRequests.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Resource: "secrets",
+			Paths: []string{
+				".data.*",
+				".stringData.*",
+				".metadata.annotations['kubectl.kubernetes.io/last-applied-configuration']",
+				// values of JSON patch operations
+				"[*].value",
+			},
+		},
+		{Group: "authentication.k8s.io", Resource: "tokenreviews", Paths: []string{".spec.token"}},
+		{Resource: "serviceaccounts", Subresource: "token", Paths: []string{".status.token"}},
+	}
+}
+
+type compiledRule struct {
+	Rule
+	paths []*Path
+}
+
+func (r *compiledRule) matches(ref *auditv1.ObjectReference) bool {
+	return ref.APIGroup == r.Group &&
+		(r.Version == "" || ref.APIVersion == r.Version) &&
+		ref.Resource == r.Resource &&
+		ref.Subresource == r.Subresource
+}
+
+// Redactor replaces sensitive fields of the request and response bodies with a
+// keyed hash of their value before the events are stored. Equal values hash to
+// the same string under the same key, so the lifecycle diff still shows that a
+// value changed without revealing it. The rules are applied to every item of a
+// list response as well.
+type Redactor struct {
+	key   []byte
+	rules []compiledRule
+}
+
+// NewRedactor creates a redactor hashing with the key, applying the default
+// rules followed by the given ones
+func NewRedactor(key []byte, rules []Rule) (*Redactor, error) {
+	if len(key) < MinKeySize {
+		return nil, fmt.Errorf("redaction key must be at least %d bytes", MinKeySize)
+	}
+	compiled, err := compileRules(append(DefaultRules(), rules...))
+	if err != nil {
+		return nil, err
+	}
+	return &Redactor{key: key, rules: compiled}, nil
+}
+
+// ValidateRules checks that every rule names a resource and has valid paths
+func ValidateRules(rules []Rule) error {
+	_, err := compileRules(rules)
+	return err
+}
+
+func compileRules(rules []Rule) ([]compiledRule, error) {
+	var compiled []compiledRule
+	var errs []error
+	for i, rule := range rules {
+		if rule.Resource == "" {
+			errs = append(errs, fmt.Errorf("redaction rule %d: resource is required", i))
+			continue
+		}
+		c := compiledRule{Rule: rule}
+		for _, raw := range rule.Paths {
+			path, err := ParsePath(raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("redaction rule %d: %w", i, err))
+				continue
+			}
+			c.paths = append(c.paths, path)
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, errors.Join(errs...)
+}
+
+// Process implements ingestion.Processor, it never drops an event
+func (r *Redactor) Process(entry *ingestion.Entry) bool {
+	r.Apply(&entry.Event)
+	return true
+}
+
+// Apply redacts the bodies of the event in place
+func (r *Redactor) Apply(event *auditv1.Event) {
+	if event.ObjectRef == nil || (event.RequestObject == nil && event.ResponseObject == nil) {
+		return
+	}
+	var paths []*Path
+	for i := range r.rules {
+		if r.rules[i].matches(event.ObjectRef) {
+			paths = append(paths, r.rules[i].paths...)
+		}
+	}
+	if len(paths) == 0 {
+		return
+	}
+	event.RequestObject = r.redact(event.RequestObject, paths)
+	event.ResponseObject = r.redact(event.ResponseObject, paths)
+}
+
+// Hash returns the redacted form of a value
+func (r *Redactor) Hash(value any) string {
+	var data []byte
+	if s, ok := value.(string); ok {
+		data = []byte(s)
+	} else if encoded, err := json.Marshal(value); err == nil {
+		data = encoded
+	}
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write(data)
+	return Prefix + hex.EncodeToString(mac.Sum(nil)[:hashSize])
+}
+
+// redact returns a copy of the object with the paths redacted. A body that
+// cannot be decoded is removed, since the fields to hide cannot be found in it.
+func (r *Redactor) redact(object *runtime.Unknown, paths []*Path) *runtime.Unknown {
+	if object == nil || len(object.Raw) == 0 {
+		return object
+	}
+	decoder := json.NewDecoder(bytes.NewReader(object.Raw))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil
+	}
+
+	replace := func(value any) any {
+		// Events redacted before, such as reprocessed dead letters, keep their hashes
+		if s, ok := value.(string); ok && strings.HasPrefix(s, Prefix) {
+			return s
+		}
+		return r.Hash(value)
+	}
+	count := 0
+	for _, path := range paths {
+		count += path.apply(document, replace)
+		if list, ok := document.(map[string]any); ok {
+			if items, ok := list["items"].([]any); ok {
+				for _, item := range items {
+					count += path.apply(item, replace)
+				}
+			}
+		}
+	}
+	if count == 0 {
+		return object
+	}
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return nil
+	}
+	redacted := *object
+	redacted.Raw = raw
+	return &redacted
+}
@@ -0,0 +1,200 @@
+package redaction_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/redaction"
+	"k8s.io/apimachinery/pkg/runtime"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newRedactor(t *testing.T, rules ...redaction.Rule) *redaction.Redactor {
+	redactor, err := redaction.NewRedactor(testKey, rules)
+	require.NoError(t, err)
+	return redactor
+}
+
+func newEvent(ref *auditv1.ObjectReference, request, response string) *auditv1.Event {
+	event := &auditv1.Event{ObjectRef: ref}
+	if request != "" {
+		event.RequestObject = &runtime.Unknown{Raw: []byte(request)}
+	}
+	if response != "" {
+		event.ResponseObject = &runtime.Unknown{Raw: []byte(response)}
+	}
+	return event
+}
+
+func decode(t *testing.T, object *runtime.Unknown) map[string]any {
+	var document map[string]any
+	require.NoError(t, json.Unmarshal(object.Raw, &document))
+	return document
+}
+
+func TestRedactor(t *testing.T) {
+	secrets := &auditv1.ObjectReference{Resource: "secrets", APIVersion: "v1", Namespace: "default", Name: "db"}
+
+	t.Run("should hash every value of Secret data", func(t *testing.T) {
+		redactor := newRedactor(t)
+		event := newEvent(secrets,
+			`{"kind":"Secret","metadata":{"name":"db","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{\"data\":{\"password\":\"aHVudGVyMg==\"}}","team":"a"}},"data":{"password":"aHVudGVyMg==","user":"YWRtaW4="},"stringData":{"token":"t0ken"}}`,
+			`{"kind":"Secret","metadata":{"name":"db"},"data":{"password":"aHVudGVyMg==","user":"YWRtaW4="}}`)
+		redactor.Apply(event)
+
+		for _, object := range []*runtime.Unknown{event.RequestObject, event.ResponseObject} {
+			assert.NotContains(t, string(object.Raw), "aHVudGVyMg==")
+			assert.NotContains(t, string(object.Raw), "YWRtaW4=")
+		}
+		assert.NotContains(t, string(event.RequestObject.Raw), "t0ken")
+
+		request, response := decode(t, event.RequestObject), decode(t, event.ResponseObject)
+		assert.Equal(t, redactor.Hash("aHVudGVyMg=="), request["data"].(map[string]any)["password"])
+		assert.Equal(t, request["data"], response["data"])
+		assert.NotEqual(t, request["data"].(map[string]any)["password"], request["data"].(map[string]any)["user"])
+		assert.Equal(t, "a", request["metadata"].(map[string]any)["annotations"].(map[string]any)["team"])
+		assert.Equal(t, "db", response["metadata"].(map[string]any)["name"])
+
+		// Redacting again keeps the hashes
+		redacted := string(event.ResponseObject.Raw)
+		redactor.Apply(event)
+		assert.JSONEq(t, redacted, string(event.ResponseObject.Raw))
+	})
+
+	t.Run("should redact the items of lists and JSON patches", func(t *testing.T) {
+		redactor := newRedactor(t)
+		list := newEvent(&auditv1.ObjectReference{Resource: "secrets", APIVersion: "v1"}, "",
+			`{"kind":"SecretList","items":[{"data":{"a":"c2VjcmV0"}},{"data":{"b":"c2VjcmV0"}}]}`)
+		redactor.Apply(list)
+		assert.NotContains(t, string(list.ResponseObject.Raw), "c2VjcmV0")
+
+		patch := newEvent(secrets, `[{"op":"replace","path":"/data/password","value":"bmV3"}]`, "")
+		redactor.Apply(patch)
+		assert.NotContains(t, string(patch.RequestObject.Raw), "bmV3")
+		assert.Contains(t, string(patch.RequestObject.Raw), "/data/password")
+	})
+
+	t.Run("should redact tokens of TokenReviews and TokenRequests", func(t *testing.T) {
+		redactor := newRedactor(t)
+		review := newEvent(&auditv1.ObjectReference{Resource: "tokenreviews", APIGroup: "authentication.k8s.io", APIVersion: "v1"},
+			`{"spec":{"token":"eyJhbGciOi"}}`, `{"spec":{"token":"eyJhbGciOi"},"status":{"authenticated":true}}`)
+		redactor.Apply(review)
+		assert.NotContains(t, string(review.RequestObject.Raw), "eyJhbGciOi")
+		assert.NotContains(t, string(review.ResponseObject.Raw), "eyJhbGciOi")
+		assert.Contains(t, string(review.ResponseObject.Raw), `"authenticated":true`)
+
+		request := newEvent(&auditv1.ObjectReference{Resource: "serviceaccounts", Subresource: "token", APIVersion: "v1"},
+			`{"spec":{"audiences":["api"]}}`, `{"status":{"token":"eyJhbGciOi","expirationTimestamp":"2026-01-01T00:00:00Z"}}`)
+		redactor.Apply(request)
+		assert.NotContains(t, string(request.ResponseObject.Raw), "eyJhbGciOi")
+		assert.Contains(t, string(request.ResponseObject.Raw), "expirationTimestamp")
+	})
+
+	t.Run("should apply configured paths to their resource only", func(t *testing.T) {
+		redactor := newRedactor(t,
+			redaction.Rule{Resource: "configmaps", Paths: []string{".data.password"}},
+			redaction.Rule{Group: "example.com", Version: "v1", Resource: "databases", Paths: []string{".spec.users[*].password"}},
+		)
+		configMap := newEvent(&auditv1.ObjectReference{Resource: "configmaps", APIVersion: "v1"}, `{"data":{"password":"hunter2","mode":"debug"}}`, "")
+		redactor.Apply(configMap)
+		assert.JSONEq(t, `{"data":{"password":"`+redactor.Hash("hunter2")+`","mode":"debug"}}`, string(configMap.RequestObject.Raw))
+
+		database := newEvent(&auditv1.ObjectReference{Resource: "databases", APIGroup: "example.com", APIVersion: "v1"},
+			`{"spec":{"users":[{"name":"a","password":"p1"},{"name":"b","password":"p2"}],"replicas":3}}`, "")
+		redactor.Apply(database)
+		assert.JSONEq(t, `{"spec":{"users":[{"name":"a","password":"`+redactor.Hash("p1")+`"},{"name":"b","password":"`+redactor.Hash("p2")+`"}],"replicas":3}}`,
+			string(database.RequestObject.Raw))
+
+		otherVersion := newEvent(&auditv1.ObjectReference{Resource: "databases", APIGroup: "example.com", APIVersion: "v2"}, `{"spec":{"users":[{"password":"p1"}]}}`, "")
+		redactor.Apply(otherVersion)
+		assert.Contains(t, string(otherVersion.RequestObject.Raw), "p1")
+
+		pod := newEvent(&auditv1.ObjectReference{Resource: "pods", APIVersion: "v1"}, `{"data":{"password":"hunter2"}}`, "")
+		original := pod.RequestObject
+		redactor.Apply(pod)
+		assert.Same(t, original, pod.RequestObject)
+	})
+
+	t.Run("should depend on the key", func(t *testing.T) {
+		other, err := redaction.NewRedactor([]byte("fedcba9876543210fedcba9876543210"), nil)
+		require.NoError(t, err)
+		redactor := newRedactor(t)
+		assert.Equal(t, redactor.Hash("value"), redactor.Hash("value"))
+		assert.NotEqual(t, redactor.Hash("value"), other.Hash("value"))
+		assert.NotEqual(t, redactor.Hash("value"), redactor.Hash("other"))
+	})
+
+	t.Run("should remove bodies of matching resources that are not JSON", func(t *testing.T) {
+		redactor := newRedactor(t)
+		event := newEvent(secrets, "\x0ak8s\x00protobuf", "")
+		redactor.Apply(event)
+		assert.Nil(t, event.RequestObject)
+	})
+
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		_, err := redaction.NewRedactor(testKey, []redaction.Rule{{Paths: []string{".data"}}, {Resource: "configmaps", Paths: []string{"data"}}})
+		assert.ErrorContains(t, err, "resource is required")
+		assert.ErrorContains(t, err, "expected . or [")
+
+		_, err = redaction.NewRedactor([]byte("short"), nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	t.Run("should parse fields, quoted keys, indexes and wildcards", func(t *testing.T) {
+		for _, path := range []string{".data.*", "$.spec.token", "{.items[*].data}", ".metadata.annotations['a.b/c']", `.spec.users[0]["pass"]`} {
+			_, err := redaction.ParsePath(path)
+			assert.NoError(t, err, path)
+		}
+	})
+
+	t.Run("should reject malformed paths", func(t *testing.T) {
+		for _, path := range []string{"", "$", ".data..x", ".spec[", ".spec[name]"} {
+			_, err := redaction.ParsePath(path)
+			assert.Error(t, err, path)
+		}
+	})
+}
+
+func TestLoadOrCreateKey(t *testing.T) {
+	t.Run("should create the key once and read it back", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "redaction.key")
+		key, created, err := redaction.LoadOrCreateKey(path)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.GreaterOrEqual(t, len(key), redaction.MinKeySize)
+
+		again, created, err := redaction.LoadOrCreateKey(path)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, key, again)
+	})
+
+	t.Run("should reject short keys", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "redaction.key")
+		require.NoError(t, os.WriteFile(path, []byte("short\n"), 0o600))
+		_, _, err := redaction.LoadOrCreateKey(path)
+		assert.Error(t, err)
+	})
+
+	t.Run("should check the key without creating it", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "redaction.key")
+		require.NoError(t, redaction.CheckKey(path))
+		assert.NoFileExists(t, path)
+		assert.Error(t, redaction.CheckKey(filepath.Join(dir, "missing", "redaction.key")))
+
+		require.NoError(t, os.WriteFile(path, []byte("short\n"), 0o600))
+		assert.Error(t, redaction.CheckKey(path))
+		_, _, err := redaction.LoadOrCreateKey(filepath.Join(dir, "valid.key"))
+		require.NoError(t, err)
+		assert.NoError(t, redaction.CheckKey(filepath.Join(dir, "valid.key")))
+	})
+}
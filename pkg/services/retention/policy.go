@@ -0,0 +1,159 @@
+package retention
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+	"sigs.k8s.io/yaml"
+)
+
+// Duration is a time.Duration that also accepts days ("3d") and weeks ("2w") in YAML
+type Duration time.Duration
+
+// ParseDuration parses a Go duration, or a whole number of days or weeks
+func ParseDuration(s string) (Duration, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			return Duration(time.Duration(count) * unit), nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	return Duration(d), nil
+}
+
+// String implements flag.Value
+func (d *Duration) String() string {
+	return time.Duration(*d).String()
+}
+
+// Set implements flag.Value
+func (d *Duration) Set(s string) error {
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"72h\" or \"3d\": %w", err)
+	}
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Rule keeps the events it matches for its TTL. Empty lists match anything.
+type Rule struct {
+	// Name identifies the rule in reports, defaults to its position
+	Name  string   `json:"name,omitempty"`
+	Verbs []string `json:"verbs,omitempty"`
+	// Resources are resource names, optionally qualified with their API group ("deployments.apps")
+	Resources  []string `json:"resources,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	TTL        Duration `json:"ttl"`
+}
+
+// Policy decides how long audit events are kept. The first matching rule applies,
+// events matching no rule are kept for DefaultTTL.
+type Policy struct {
+	// DefaultTTL is the retention of events matching no rule, 0 keeps them forever
+	DefaultTTL Duration `json:"defaultTTL,omitempty"`
+	Rules      []Rule   `json:"rules,omitempty"`
+}
+
+// LoadPolicy reads a YAML retention policy
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse retention policy %s: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retention policy %s: %w", path, err)
+	}
+	return &policy, nil
+}
+
+// Validate checks that every TTL is positive
+func (p *Policy) Validate() error {
+	if p.DefaultTTL < 0 {
+		return fmt.Errorf("defaultTTL must not be negative")
+	}
+	for i, rule := range p.Rules {
+		if rule.TTL <= 0 {
+			return fmt.Errorf("rule %s must have a positive ttl", ruleName(i, rule))
+		}
+	}
+	return nil
+}
+
+// Enabled reports whether the policy expires any event
+func (p *Policy) Enabled() bool {
+	return p.DefaultTTL > 0 || len(p.Rules) > 0
+}
+
+func ruleName(i int, rule Rule) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return fmt.Sprintf("#%d", i+1)
+}
+
+// matchesAll reports whether the rule has no condition, making later rules unreachable
+func (r *Rule) matchesAll() bool {
+	return len(r.Verbs) == 0 && len(r.Resources) == 0 && len(r.Namespaces) == 0
+}
+
+// matching returns the predicate selecting the events matched by the rule,
+// which must not match everything
+func (r *Rule) matching() predicate.AuditEvent {
+	var conditions []predicate.AuditEvent
+	if len(r.Verbs) > 0 {
+		conditions = append(conditions, auditevent.VerbIn(r.Verbs...))
+	}
+	if len(r.Resources) > 0 {
+		resources := make([]predicate.AuditEvent, 0, len(r.Resources))
+		for _, resource := range r.Resources {
+			name, group, qualified := strings.Cut(resource, ".")
+			if qualified {
+				resources = append(resources, auditevent.And(auditevent.ResourceEQ(name), auditevent.ApiGroupEQ(group)))
+			} else {
+				resources = append(resources, auditevent.ResourceEQ(name))
+			}
+		}
+		conditions = append(conditions, auditevent.Or(resources...))
+	}
+	if len(r.Namespaces) > 0 {
+		conditions = append(conditions, auditevent.NamespaceIn(r.Namespaces...))
+	}
+	return auditevent.And(conditions...)
+}
@@ -0,0 +1,244 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditannotation"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/predicate"
+)
+
+// DefaultRuleName names the default TTL in reports
+const DefaultRuleName = "default"
+
+// Options configures the pruner
+type Options struct {
+	// Interval is how often expired events are pruned in the background
+	Interval time.Duration
+	// BatchSize is the number of events deleted per transaction. Small batches keep
+	// the database write lock short so ingestion is not blocked.
+	BatchSize int
+	// BatchPause is the pause between two batches, leaving room for other writers
+	BatchPause time.Duration
+	// VacuumInterval is the minimum time between two SQLite VACUUMs after pruning, 0 disables them
+	VacuumInterval time.Duration
+}
+
+// DefaultOptions returns the options used when nothing is configured
+func DefaultOptions() Options {
+	return Options{
+		Interval:       time.Hour,
+		BatchSize:      1000,
+		BatchPause:     100 * time.Millisecond,
+		VacuumInterval: 24 * time.Hour,
+	}
+}
+
+// RuleReport is the number of expired events of one rule
+type RuleReport struct {
+	Rule    string        `json:"rule"`
+	TTL     time.Duration `json:"ttl"`
+	Expired int           `json:"expired"`
+}
+
+// Report summarizes one pruning pass
+type Report struct {
+	Rules    []RuleReport `json:"rules"`
+	Deleted  int          `json:"deleted"`
+	Vacuumed bool         `json:"vacuumed"`
+}
+
+// Pruner deletes the audit events expired by a retention policy
+type Pruner struct {
+	client *ent.Client
+	driver dialect.Driver
+	policy *Policy
+	opts   Options
+	logger *slog.Logger
+
+	lastVacuum time.Time
+	// deletedSinceVacuum is the number of events deleted since the last VACUUM
+	deletedSinceVacuum int
+}
+
+// NewPruner creates a pruner. The driver is used for SQLite VACUUM and may be nil.
+func NewPruner(client *ent.Client, driver dialect.Driver, policy *Policy, opts Options) *Pruner {
+	defaults := DefaultOptions()
+	if opts.Interval <= 0 {
+		opts.Interval = defaults.Interval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	return &Pruner{
+		client:     client,
+		driver:     driver,
+		policy:     policy,
+		opts:       opts,
+		logger:     slog.Default().With("component", "retention"),
+		lastVacuum: time.Now(),
+	}
+}
+
+// Run prunes at every interval until the context is cancelled
+func (p *Pruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.opts.Interval)
+	defer ticker.Stop()
+	for {
+		report, err := p.Prune(ctx, false)
+		if err != nil && ctx.Err() == nil {
+			p.logger.Error("failed to prune expired audit events", "error", err)
+		} else if report != nil && report.Deleted > 0 {
+			p.logger.Info("pruned expired audit events", "deleted", report.Deleted, "vacuumed", report.Vacuumed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune deletes the expired events, or only counts them in dry-run mode.
+// Each event is expired by the first rule matching it, or by the default TTL.
+func (p *Pruner) Prune(ctx context.Context, dryRun bool) (*Report, error) {
+	report := &Report{}
+	now := time.Now()
+
+	// Events matched by an earlier rule are not considered by later ones
+	var earlier []predicate.AuditEvent
+	exclude := func() []predicate.AuditEvent {
+		if len(earlier) == 0 {
+			return nil
+		}
+		return []predicate.AuditEvent{auditevent.Not(auditevent.Or(earlier...))}
+	}
+
+	catchAll := false
+	for i, rule := range p.policy.Rules {
+		conditions := exclude()
+		if rule.matchesAll() {
+			catchAll = true
+		} else {
+			conditions = append(conditions, rule.matching())
+			earlier = append(earlier, rule.matching())
+		}
+		ruleReport, err := p.expire(ctx, ruleName(i, rule), time.Duration(rule.TTL), now, conditions, dryRun)
+		report.Rules = append(report.Rules, ruleReport)
+		report.Deleted += ruleReport.Expired
+		if err != nil {
+			return report, err
+		}
+		if catchAll {
+			break
+		}
+	}
+
+	if !catchAll && p.policy.DefaultTTL > 0 {
+		ruleReport, err := p.expire(ctx, DefaultRuleName, time.Duration(p.policy.DefaultTTL), now, exclude(), dryRun)
+		report.Rules = append(report.Rules, ruleReport)
+		report.Deleted += ruleReport.Expired
+		if err != nil {
+			return report, err
+		}
+	}
+
+	if dryRun {
+		report.Deleted = 0
+		return report, nil
+	}
+	p.deletedSinceVacuum += report.Deleted
+	vacuumed, err := p.maybeVacuum(ctx, now)
+	report.Vacuumed = vacuumed
+	return report, err
+}
+
+// expire deletes, or counts in dry-run mode, the events matching the conditions older than the TTL
+func (p *Pruner) expire(ctx context.Context, name string, ttl time.Duration, now time.Time, conditions []predicate.AuditEvent, dryRun bool) (RuleReport, error) {
+	report := RuleReport{Rule: name, TTL: ttl}
+	conditions = append(conditions, auditevent.RequestTimestampLT(now.Add(-ttl)))
+
+	if dryRun {
+		count, err := p.client.AuditEvent.Query().Where(conditions...).Count(ctx)
+		report.Expired = count
+		return report, err
+	}
+
+	for {
+		ids, err := p.client.AuditEvent.Query().Where(conditions...).Limit(p.opts.BatchSize).IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return report, err
+		}
+		deleted, err := p.deleteBatch(ctx, ids)
+		report.Expired += deleted
+		if err != nil {
+			return report, err
+		}
+		if len(ids) < p.opts.BatchSize {
+			return report, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return report, ctx.Err()
+		case <-time.After(p.opts.BatchPause):
+		}
+	}
+}
+
+// deleteBatch deletes the events and their annotations in one short transaction
+func (p *Pruner) deleteBatch(ctx context.Context, ids []int) (int, error) {
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.AuditAnnotation.Delete().
+		Where(auditannotation.HasEventWith(auditevent.IDIn(ids...))).
+		Exec(ctx); err != nil {
+		return 0, rollback(tx, err)
+	}
+	deleted, err := tx.AuditEvent.Delete().Where(auditevent.IDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return deleted, tx.Commit()
+}
+
+// maybeVacuum reclaims the space of deleted events on SQLite, at most once per VacuumInterval
+func (p *Pruner) maybeVacuum(ctx context.Context, now time.Time) (bool, error) {
+	if p.driver == nil || p.driver.Dialect() != dialect.SQLite || p.opts.VacuumInterval <= 0 {
+		return false, nil
+	}
+	if p.deletedSinceVacuum == 0 || now.Sub(p.lastVacuum) < p.opts.VacuumInterval {
+		return false, nil
+	}
+	if err := Vacuum(ctx, p.driver); err != nil {
+		return false, err
+	}
+	p.lastVacuum = now
+	p.deletedSinceVacuum = 0
+	return true, nil
+}
+
+// Vacuum rebuilds a SQLite database file to return the space of deleted rows to the
+// file system. It needs free disk space for a copy of the database and blocks
+// writers while it runs. Other databases reclaim space on their own.
+func Vacuum(ctx context.Context, driver dialect.Driver) error {
+	if driver.Dialect() != dialect.SQLite {
+		return nil
+	}
+	return driver.Exec(ctx, "VACUUM", []any{}, nil)
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
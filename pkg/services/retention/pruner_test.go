@@ -0,0 +1,172 @@
+package retention_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/retention"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func setupTestDB(t *testing.T) *ent.Client {
+	dbName := fmt.Sprintf("file:retention_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63())
+	client := enttest.Open(t, "sqlite3", dbName)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func createEvent(t *testing.T, client *ent.Client, auditID, verb, resource, namespace string, age time.Duration) {
+	requestTime := time.Now().Add(-age)
+	event, err := client.AuditEvent.Create().
+		SetRaw("{}").
+		SetLevel("RequestResponse").
+		SetAuditID(auditID).
+		SetVerb(verb).
+		SetUserAgent("kubectl/v1.30.0").
+		SetRequestTimestamp(requestTime).
+		SetStageTimestamp(requestTime).
+		SetStage("ResponseComplete").
+		SetResource(resource).
+		SetNamespace(namespace).
+		Save(context.Background())
+	require.NoError(t, err)
+	_, err = client.AuditAnnotation.Create().SetKey("authorization.k8s.io/decision").SetValue("allow").SetEvent(event).Save(context.Background())
+	require.NoError(t, err)
+}
+
+func remainingIDs(t *testing.T, client *ent.Client) []string {
+	events, err := client.AuditEvent.Query().All(context.Background())
+	require.NoError(t, err)
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.AuditID
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+const day = 24 * time.Hour
+
+func TestPruner(t *testing.T) {
+	policy := &retention.Policy{
+		DefaultTTL: retention.Duration(30 * day),
+		Rules: []retention.Rule{
+			{Name: "secrets", Resources: []string{"secrets"}, TTL: retention.Duration(365 * day)},
+			{Name: "kube-system", Namespaces: []string{"kube-system"}, TTL: retention.Duration(7 * day)},
+		},
+	}
+
+	seed := func(t *testing.T, client *ent.Client) {
+		createEvent(t, client, "recent", "create", "pods", "default", day)
+		createEvent(t, client, "old", "create", "pods", "default", 60*day)
+		createEvent(t, client, "old-secret", "update", "secrets", "kube-system", 60*day)
+		createEvent(t, client, "ancient-secret", "update", "secrets", "default", 400*day)
+		createEvent(t, client, "old-system", "patch", "configmaps", "kube-system", 10*day)
+	}
+
+	t.Run("should delete events expired by the first matching rule or the default TTL", func(t *testing.T) {
+		ctx := context.Background()
+		client := setupTestDB(t)
+		seed(t, client)
+
+		report, err := retention.NewPruner(client, nil, policy, retention.Options{BatchSize: 1}).Prune(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.Deleted)
+		assert.Equal(t, []retention.RuleReport{
+			{Rule: "secrets", TTL: 365 * day, Expired: 1},
+			{Rule: "kube-system", TTL: 7 * day, Expired: 1},
+			{Rule: retention.DefaultRuleName, TTL: 30 * day, Expired: 1},
+		}, report.Rules)
+		assert.Equal(t, []string{"old-secret", "recent"}, remainingIDs(t, client))
+
+		annotations, err := client.AuditAnnotation.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, annotations)
+	})
+
+	t.Run("should only count expired events in dry-run mode", func(t *testing.T) {
+		client := setupTestDB(t)
+		seed(t, client)
+
+		report, err := retention.NewPruner(client, nil, policy, retention.Options{}).Prune(context.Background(), true)
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.Deleted)
+		assert.Len(t, report.Rules, 3)
+		for _, rule := range report.Rules {
+			assert.Equal(t, 1, rule.Expired, rule.Rule)
+		}
+		assert.Len(t, remainingIDs(t, client), 5)
+	})
+
+	t.Run("should match verbs and group qualified resources", func(t *testing.T) {
+		client := setupTestDB(t)
+		createEvent(t, client, "delete", "delete", "pods", "default", 2*day)
+		createEvent(t, client, "create", "create", "pods", "default", 2*day)
+
+		verbPolicy := &retention.Policy{Rules: []retention.Rule{
+			{Verbs: []string{"delete"}, Resources: []string{"pods", "deployments.apps"}, TTL: retention.Duration(day)},
+		}}
+		report, err := retention.NewPruner(client, nil, verbPolicy, retention.Options{}).Prune(context.Background(), false)
+		require.NoError(t, err)
+		assert.Equal(t, "#1", report.Rules[0].Rule)
+		assert.Equal(t, []string{"create"}, remainingIDs(t, client))
+	})
+
+	t.Run("should ignore rules after a rule matching everything", func(t *testing.T) {
+		client := setupTestDB(t)
+		seed(t, client)
+
+		catchAll := &retention.Policy{
+			DefaultTTL: retention.Duration(day / 2),
+			Rules:      []retention.Rule{{Name: "all", TTL: retention.Duration(100 * day)}, {Name: "unreachable", TTL: retention.Duration(day / 2)}},
+		}
+		report, err := retention.NewPruner(client, nil, catchAll, retention.Options{}).Prune(context.Background(), false)
+		require.NoError(t, err)
+		assert.Equal(t, []retention.RuleReport{{Rule: "all", TTL: 100 * day, Expired: 1}}, report.Rules)
+	})
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Run("should parse day and week durations", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "retention.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+defaultTTL: 30d
+rules:
+  - name: secrets
+    resources: [secrets]
+    ttl: 2w
+  - verbs: [delete]
+    ttl: 36h
+`), 0o600))
+
+		policy, err := retention.LoadPolicy(path)
+		require.NoError(t, err)
+		assert.Equal(t, retention.Duration(30*day), policy.DefaultTTL)
+		assert.Equal(t, retention.Duration(14*day), policy.Rules[0].TTL)
+		assert.Equal(t, retention.Duration(36*time.Hour), policy.Rules[1].TTL)
+	})
+
+	t.Run("should reject rules without ttl and unknown fields", func(t *testing.T) {
+		dir := t.TempDir()
+		missing := filepath.Join(dir, "missing.yaml")
+		require.NoError(t, os.WriteFile(missing, []byte("rules:\n  - verbs: [get]\n"), 0o600))
+		_, err := retention.LoadPolicy(missing)
+		assert.ErrorContains(t, err, "positive ttl")
+
+		unknown := filepath.Join(dir, "unknown.yaml")
+		require.NoError(t, os.WriteFile(unknown, []byte("keep: 3d\n"), 0o600))
+		_, err = retention.LoadPolicy(unknown)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,14 @@
+package sampling
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewStatsHandler returns a gin handler exposing the sampler statistics as JSON
+func NewStatsHandler(sampler *Sampler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, sampler.Stats())
+	}
+}
@@ -0,0 +1,283 @@
+package sampling
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+)
+
+// Fields an event may be keyed by
+const (
+	KeyUser      = "user"
+	KeyResource  = "resource"
+	KeyNamespace = "namespace"
+	KeyVerb      = "verb"
+	KeyCluster   = "cluster"
+	KeyUserAgent = "userAgent"
+)
+
+const (
+	// idleTimeout is how long a key is remembered after its last event, in event time
+	idleTimeout = 10 * time.Minute
+	// sweepInterval is the number of sampled events between two removals of the idle keys
+	sweepInterval = 1024
+)
+
+// DefaultVerbs are the verbs sampled when Config.Verbs is empty
+var DefaultVerbs = []string{"get", "list", "watch"}
+
+// DefaultKeyBy groups the events by user and resource when Config.KeyBy is empty
+var DefaultKeyBy = []string{KeyUser, KeyResource}
+
+var keyFields = map[string]bool{KeyUser: true, KeyResource: true, KeyNamespace: true, KeyVerb: true, KeyCluster: true, KeyUserAgent: true}
+
+// Config selects the sampled events and how many of them are kept. Exactly one
+// of OneIn and PerMinute is set when sampling is enabled.
+type Config struct {
+	// Verbs are the sampled verbs, DefaultVerbs when empty
+	Verbs []string `json:"verbs,omitempty"`
+	// KeyBy lists the fields grouping the sampled events, DefaultKeyBy when empty
+	KeyBy []string `json:"keyBy,omitempty"`
+	// OneIn keeps the first event of a key, then one in every OneIn, picked by audit ID
+	OneIn int `json:"oneIn,omitempty"`
+	// PerMinute keeps the first PerMinute events of a key in every minute of request time
+	PerMinute int `json:"perMinute,omitempty"`
+}
+
+// Enabled reports whether events are sampled
+func (c *Config) Enabled() bool {
+	return c.OneIn > 1 || c.PerMinute > 0
+}
+
+// Validate checks the sampling mode and the key fields
+func (c *Config) Validate() error {
+	var errs []error
+	if c.OneIn < 0 || c.PerMinute < 0 {
+		errs = append(errs, errors.New("sampling oneIn and perMinute must not be negative"))
+	}
+	if c.OneIn > 1 && c.PerMinute > 0 {
+		errs = append(errs, errors.New("sampling oneIn and perMinute are mutually exclusive"))
+	}
+	for _, field := range c.KeyBy {
+		if !keyFields[field] {
+			errs = append(errs, fmt.Errorf("unknown sampling key %q", field))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Stats counts what the sampler did to the events it evaluated
+type Stats struct {
+	// Evaluated is the number of events with a sampled verb
+	Evaluated int64 `json:"evaluated"`
+	Kept      int64 `json:"kept"`
+	Dropped   int64 `json:"dropped"`
+	// Keys is the number of keys currently remembered
+	Keys int `json:"keys"`
+}
+
+// keyState is what the sampler remembers of a key
+type keyState struct {
+	// first is the audit ID of the first event of the key, always kept
+	first string
+	// window is the minute of request time inWindow counts the kept events of
+	window   time.Time
+	inWindow int
+	// decided and previous are the weights given by PerMinute to each audit ID
+	// hash in window and in the minute before, 0 when dropped
+	decided  map[uint64]int
+	previous map[uint64]int
+	// pending is the weight of the events dropped since the last kept one
+	pending  int
+	lastSeen time.Time
+}
+
+// Sampler keeps a fraction of high-volume events, such as reads, per key.
+// A kept event carries a sample weight, the number of received events it
+// stands for, so that summing the weights of the stored events estimates the
+// received volume.
+//
+// The decision only depends on the event, so that an event resent after its
+// batch was rejected is kept or dropped again, with the same weight. With
+// OneIn, the first event of a key is kept and the others are kept when their
+// audit ID hashes to one in OneIn, weighted OneIn. With PerMinute, the
+// decisions are remembered by audit ID for the current and the previous minute
+// of the key, and a kept event is weighted by the events of its key dropped
+// since the previous kept one. Keys idle for more than ten minutes are forgotten.
+type Sampler struct {
+	config Config
+	verbs  map[string]bool
+
+	mu        sync.Mutex
+	keys      map[string]*keyState
+	newest    time.Time
+	processed int
+
+	evaluated atomic.Int64
+	kept      atomic.Int64
+	dropped   atomic.Int64
+}
+
+// NewSampler creates a sampler, the configuration must be valid and enabled
+func NewSampler(config Config) *Sampler {
+	if len(config.Verbs) == 0 {
+		config.Verbs = DefaultVerbs
+	}
+	if len(config.KeyBy) == 0 {
+		config.KeyBy = DefaultKeyBy
+	}
+	s := &Sampler{config: config, verbs: map[string]bool{}, keys: map[string]*keyState{}}
+	for _, verb := range config.Verbs {
+		s.verbs[verb] = true
+	}
+	return s
+}
+
+// Process implements ingestion.Processor
+func (s *Sampler) Process(entry *ingestion.Entry) bool {
+	if !s.verbs[entry.Event.Verb] {
+		return true
+	}
+	s.evaluated.Add(1)
+	auditID := string(entry.Event.AuditID)
+	weight := max(entry.SampleWeight, 1)
+	timestamp := entry.Event.RequestReceivedTimestamp.Time
+	key := s.key(entry)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.keys[key]
+	if !ok {
+		state = &keyState{first: auditID}
+		s.keys[key] = state
+	}
+	if timestamp.After(state.lastSeen) {
+		state.lastSeen = timestamp
+	}
+	if timestamp.After(s.newest) {
+		s.newest = timestamp
+	}
+	s.processed++
+	if s.processed%sweepInterval == 0 {
+		s.sweep()
+	}
+
+	if s.config.PerMinute > 0 {
+		weight = s.limit(state, auditID, timestamp, weight)
+	} else if auditID != state.first {
+		weight = s.oneIn(auditID, weight)
+	}
+	if weight == 0 {
+		s.dropped.Add(1)
+		return false
+	}
+	entry.SampleWeight = weight
+	s.kept.Add(1)
+	return true
+}
+
+// oneIn returns the weight of an event kept for its audit ID, 0 when dropped
+func (s *Sampler) oneIn(auditID string, weight int) int {
+	if hash(auditID)%uint64(s.config.OneIn) != 0 {
+		return 0
+	}
+	return weight * s.config.OneIn
+}
+
+// limit returns the weight of an event kept within the PerMinute of its key,
+// 0 when dropped, or the decision already taken for its audit ID
+func (s *Sampler) limit(state *keyState, auditID string, timestamp time.Time, weight int) int {
+	id := hash(auditID)
+	if decided, ok := state.decided[id]; ok {
+		return decided
+	}
+	if decided, ok := state.previous[id]; ok {
+		return decided
+	}
+	// Events arriving late are counted in the current window
+	if window := timestamp.Truncate(time.Minute); window.After(state.window) {
+		state.previous = nil
+		if window.Sub(state.window) == time.Minute {
+			state.previous = state.decided
+		}
+		state.decided = nil
+		state.window = window
+		state.inWindow = 0
+	}
+	if state.decided == nil {
+		state.decided = map[uint64]int{}
+	}
+	if state.inWindow >= s.config.PerMinute {
+		state.pending += weight
+		state.decided[id] = 0
+		return 0
+	}
+	state.inWindow++
+	weight += state.pending
+	state.pending = 0
+	state.decided[id] = weight
+	return weight
+}
+
+// hash spreads the audit IDs uniformly
+func hash(auditID string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(auditID))
+	return h.Sum64()
+}
+
+// key joins the configured fields of the event
+func (s *Sampler) key(entry *ingestion.Entry) string {
+	event := &entry.Event
+	parts := make([]string, len(s.config.KeyBy))
+	for i, field := range s.config.KeyBy {
+		switch field {
+		case KeyUser:
+			parts[i] = event.User.Username
+		case KeyResource:
+			if event.ObjectRef != nil {
+				parts[i] = event.ObjectRef.APIGroup + "/" + event.ObjectRef.Resource + "/" + event.ObjectRef.Subresource
+			}
+		case KeyNamespace:
+			if event.ObjectRef != nil {
+				parts[i] = event.ObjectRef.Namespace
+			}
+		case KeyVerb:
+			parts[i] = event.Verb
+		case KeyCluster:
+			parts[i] = entry.Cluster
+		case KeyUserAgent:
+			parts[i] = event.UserAgent
+		}
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// sweep forgets the keys idle for longer than idleTimeout
+func (s *Sampler) sweep() {
+	cutoff := s.newest.Add(-idleTimeout)
+	for key, state := range s.keys {
+		if state.lastSeen.Before(cutoff) {
+			delete(s.keys, key)
+		}
+	}
+}
+
+// Stats returns a snapshot of the sampler counters
+func (s *Sampler) Stats() Stats {
+	s.mu.Lock()
+	keys := len(s.keys)
+	s.mu.Unlock()
+	return Stats{
+		Evaluated: s.evaluated.Load(),
+		Kept:      s.kept.Load(),
+		Dropped:   s.dropped.Load(),
+		Keys:      keys,
+	}
+}
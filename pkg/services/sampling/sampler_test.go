@@ -0,0 +1,188 @@
+package sampling_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/auditevent"
+	"github.com/strrl/kubernetes-auditing-dashboard/ent/enttest"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/ingestion"
+	"github.com/strrl/kubernetes-auditing-dashboard/pkg/services/sampling"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var base = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newEvent(auditID, verb, username, resource string, requestTime time.Time) auditv1.Event {
+	return auditv1.Event{
+		Level:                    auditv1.LevelMetadata,
+		AuditID:                  types.UID(auditID),
+		Stage:                    auditv1.StageResponseComplete,
+		Verb:                     verb,
+		User:                     authnv1.UserInfo{Username: username},
+		UserAgent:                "kubectl/v1.30.0",
+		RequestReceivedTimestamp: metav1.NewMicroTime(requestTime),
+		StageTimestamp:           metav1.NewMicroTime(requestTime),
+		ObjectRef:                &auditv1.ObjectReference{Resource: resource, Namespace: "default", APIVersion: "v1"},
+	}
+}
+
+// sample runs the sampler on the events and returns the weights of the kept ones, 0 for the dropped ones
+func sample(sampler *sampling.Sampler, events ...auditv1.Event) []int {
+	weights := make([]int, len(events))
+	for i := range events {
+		entry := ingestion.Entry{Event: events[i], Cluster: "default"}
+		if sampler.Process(&entry) {
+			weights[i] = entry.SampleWeight
+		}
+	}
+	return weights
+}
+
+func TestSampler(t *testing.T) {
+	t.Run("should keep the first read of a key, then one in N picked by audit ID", func(t *testing.T) {
+		sampler := sampling.NewSampler(sampling.Config{OneIn: 3})
+		var events []auditv1.Event
+		for i := 0; i < 300; i++ {
+			events = append(events, newEvent(fmt.Sprint("a", i), "list", "alice", "pods", base))
+		}
+		events = append(events, newEvent("b", "get", "bob", "pods", base), newEvent("c", "get", "alice", "secrets", base))
+
+		weights := sample(sampler, events...)
+		assert.Equal(t, 1, weights[0])
+		assert.Equal(t, []int{1, 1}, weights[300:], "the first read of every key is kept")
+		total := 0
+		for _, weight := range weights[1:300] {
+			assert.Contains(t, []int{0, 3}, weight)
+			total += weight
+		}
+		assert.InDelta(t, 299, total, 60, "the weights estimate the number of reads")
+		stats := sampler.Stats()
+		assert.Equal(t, int64(302), stats.Evaluated)
+		assert.Equal(t, stats.Evaluated, stats.Kept+stats.Dropped)
+		assert.Equal(t, 3, stats.Keys)
+	})
+
+	t.Run("should keep every other verb unweighted", func(t *testing.T) {
+		sampler := sampling.NewSampler(sampling.Config{OneIn: 100})
+		weights := sample(sampler,
+			newEvent("a", "delete", "alice", "pods", base),
+			newEvent("b", "delete", "alice", "pods", base),
+			newEvent("c", "patch", "alice", "pods", base))
+
+		assert.Equal(t, []int{0, 0, 0}, weights, "the weight of unsampled entries is left unset")
+		assert.Zero(t, sampler.Stats().Evaluated)
+	})
+
+	t.Run("should keep N reads per key and minute", func(t *testing.T) {
+		sampler := sampling.NewSampler(sampling.Config{PerMinute: 2})
+		weights := sample(sampler,
+			newEvent("a", "watch", "alice", "pods", base),
+			newEvent("b", "watch", "alice", "pods", base.Add(10*time.Second)),
+			newEvent("c", "watch", "alice", "pods", base.Add(20*time.Second)),
+			newEvent("d", "watch", "alice", "pods", base.Add(30*time.Second)),
+			newEvent("e", "watch", "alice", "pods", base.Add(70*time.Second)),
+			// Received late, counted in the current minute
+			newEvent("f", "watch", "alice", "pods", base.Add(40*time.Second)),
+			newEvent("g", "watch", "alice", "pods", base.Add(80*time.Second)))
+
+		assert.Equal(t, []int{1, 1, 0, 0, 3, 1, 0}, weights)
+	})
+
+	t.Run("should group the reads by the configured fields", func(t *testing.T) {
+		sampler := sampling.NewSampler(sampling.Config{PerMinute: 1, Verbs: []string{"get"}, KeyBy: []string{sampling.KeyResource}})
+		weights := sample(sampler,
+			newEvent("a", "get", "alice", "pods", base),
+			newEvent("b", "get", "bob", "pods", base),
+			newEvent("c", "get", "carol", "pods", base),
+			newEvent("d", "list", "alice", "pods", base),
+			newEvent("e", "get", "alice", "pods", base.Add(time.Minute)))
+
+		assert.Equal(t, []int{1, 0, 0, 0, 3}, weights)
+	})
+
+	t.Run("should take the same decisions on resent events", func(t *testing.T) {
+		for _, config := range []sampling.Config{{OneIn: 3}, {PerMinute: 2}} {
+			sampler := sampling.NewSampler(config)
+			var events []auditv1.Event
+			// Decisions are remembered for the current and the previous minute
+			for i := 0; i < 24; i++ {
+				events = append(events, newEvent(fmt.Sprint("a", i), "watch", "alice", "pods", base.Add(time.Duration(i)*5*time.Second)))
+			}
+			weights := sample(sampler, events...)
+			assert.Equal(t, weights, sample(sampler, events...), config)
+			assert.Equal(t, weights[16:], sample(sampler, events[16:]...), config)
+		}
+	})
+
+	t.Run("should reject invalid configurations", func(t *testing.T) {
+		for _, config := range []sampling.Config{
+			{OneIn: -1},
+			{OneIn: 10, PerMinute: 10},
+			{OneIn: 10, KeyBy: []string{"pod"}},
+		} {
+			assert.Error(t, config.Validate(), config)
+		}
+		assert.NoError(t, (&sampling.Config{PerMinute: 10, KeyBy: []string{sampling.KeyCluster, sampling.KeyUserAgent}}).Validate())
+		assert.False(t, (&sampling.Config{OneIn: 1}).Enabled())
+	})
+}
+
+func TestSampledIngestion(t *testing.T) {
+	newService := func(t *testing.T, config sampling.Config) (*ent.Client, *ingestion.Service) {
+		client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:sampling_%d_%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano(), rand.Int63()))
+		t.Cleanup(func() { client.Close() })
+		return client, ingestion.NewService(client, ingestion.WithProcessors(sampling.NewSampler(config)))
+	}
+
+	t.Run("should store the sample weight of the kept reads", func(t *testing.T) {
+		ctx := context.Background()
+		client, service := newService(t, sampling.Config{PerMinute: 1})
+		result, err := service.Ingest(ctx, "", []auditv1.Event{
+			newEvent("a", "list", "alice", "pods", base),
+			newEvent("b", "list", "alice", "pods", base),
+			newEvent("c", "list", "alice", "pods", base.Add(time.Minute)),
+			newEvent("d", "delete", "alice", "pods", base),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Dropped)
+
+		events, err := client.AuditEvent.Query().Order(auditevent.ByAuditID()).All(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, []int{1, 2, 1}, []int{events[0].SampleWeight, events[1].SampleWeight, events[2].SampleWeight})
+	})
+
+	t.Run("should keep the same events with the same weights when a batch is submitted twice", func(t *testing.T) {
+		for _, config := range []sampling.Config{{OneIn: 4}, {PerMinute: 3}} {
+			_, service := newService(t, config)
+			var events []auditv1.Event
+			for i := 0; i < 12; i++ {
+				events = append(events, newEvent(fmt.Sprint("a", i), "get", "alice", "pods", base.Add(time.Duration(i)*10*time.Second)))
+			}
+
+			kept := func() map[string]int {
+				entries, _ := service.Prepare("", events)
+				weights := map[string]int{}
+				for _, entry := range entries {
+					weights[string(entry.Event.AuditID)] = entry.SampleWeight
+				}
+				return weights
+			}
+			first := kept()
+			assert.Less(t, len(first), len(events), config)
+			assert.Equal(t, first, kept(), config)
+		}
+	})
+}